@@ -0,0 +1,13 @@
+# Returns true
+output "contains_address" {
+  value = provider::ubicloud::cidr_contains("fd10:9b0b:6b4b:8fbb::/64", "fd10:9b0b:6b4b:8fbb::2")
+}
+
+variable "app_cidr" {
+  type = string
+
+  validation {
+    condition     = provider::ubicloud::cidr_contains("10.10.0.0/16", var.app_cidr)
+    error_message = "The application range must be within 10.10.0.0/16."
+  }
+}
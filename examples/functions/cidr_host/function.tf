@@ -0,0 +1,10 @@
+# Returns "10.10.16.5"
+output "ipv4_host" {
+  value = provider::ubicloud::cidr_host("10.10.16.0/20", 5)
+}
+
+# Returns "fd10:9b0b:6b4b:8fbb:ffff:ffff:ffff:ffff", the last address of the
+# subnet.
+output "ipv6_last_host" {
+  value = provider::ubicloud::cidr_host("fd10:9b0b:6b4b:8fbb::/64", -1)
+}
@@ -0,0 +1,11 @@
+# Returns false
+output "overlaps" {
+  value = provider::ubicloud::cidr_overlaps("10.10.0.0/16", "10.20.0.0/16")
+}
+
+check "subnets_disjoint" {
+  assert {
+    condition     = !provider::ubicloud::cidr_overlaps(ubicloud_private_subnet.a.net6, ubicloud_private_subnet.b.net6)
+    error_message = "Private subnets must not overlap."
+  }
+}
@@ -0,0 +1,16 @@
+import {
+  to = ubicloud_vm.example
+  id = provider::ubicloud::import_id("pj01qy4sty1j7nycv8hfqmgy6t", "eu-central-h1", "my-vm")
+}
+
+# The id of the rule follows the name of the firewall
+import {
+  to = ubicloud_firewall_rule.example
+  id = provider::ubicloud::import_id("pj01qy4sty1j7nycv8hfqmgy6t", "eu-central-h1", "my-firewall", "fr01gvfq90e0k4r7z2m8q5w1x3")
+}
+
+# SSH public keys don't belong to a location, their id follows the project id
+import {
+  to = ubicloud_ssh_public_key.example
+  id = provider::ubicloud::import_id("pj01qy4sty1j7nycv8hfqmgy6t", "sk01qy4sty1j7nycv8hfqmgy6t")
+}
@@ -0,0 +1,4 @@
+# Returns "::1a2b:3c4d:5e6f:7081"
+output "interface_id" {
+  value = provider::ubicloud::ipv6_interface_id("2a01:4f8:10a:128b:1a2b:3c4d:5e6f:7081")
+}
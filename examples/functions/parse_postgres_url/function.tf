@@ -0,0 +1,18 @@
+locals {
+  postgres = provider::ubicloud::parse_postgres_url(data.ubicloud_postgres.example.connection_string)
+}
+
+resource "kubernetes_secret" "postgres" {
+  metadata {
+    name = "postgres"
+  }
+
+  data = {
+    host     = local.postgres.host
+    port     = local.postgres.port
+    user     = local.postgres.user
+    password = local.postgres.password
+    dbname   = local.postgres.dbname
+    sslmode  = local.postgres.sslmode
+  }
+}
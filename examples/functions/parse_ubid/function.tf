@@ -0,0 +1,8 @@
+variable "private_subnet_id" {
+  type = string
+
+  validation {
+    condition     = provider::ubicloud::parse_ubid(var.private_subnet_id).type == "ps"
+    error_message = "private_subnet_id must be the id of a private subnet."
+  }
+}
@@ -24,3 +24,21 @@ resource "ubicloud_firewall_rule" "ssh" {
   cidr          = "0.0.0.0/0"
   port_range    = "22..22"
 }
+
+# Rules can also be managed authoritatively on the firewall itself.
+resource "ubicloud_firewall" "web" {
+  project_id  = var.project_id
+  location    = var.location
+  name        = "web-firewall"
+  description = "Allow HTTP and HTTPS"
+
+  rule {
+    cidr       = "0.0.0.0/0"
+    port_range = "80..80"
+  }
+
+  rule {
+    cidr       = "0.0.0.0/0"
+    port_range = "443..443"
+  }
+}
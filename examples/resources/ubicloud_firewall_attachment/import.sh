@@ -0,0 +1 @@
+terraform import ubicloud_firewall_attachment.example <project_id>,<location>,<firewall_name>,<private_subnet_id>
@@ -0,0 +1,19 @@
+resource "ubicloud_firewall" "web" {
+  project_id  = "pj01qy4sty1j7nycv8hfqmgy6t"
+  location    = "eu-central-h1"
+  name        = "web"
+  description = "Allow HTTP and HTTPS"
+}
+
+resource "ubicloud_private_subnet" "web" {
+  project_id = "pj01qy4sty1j7nycv8hfqmgy6t"
+  location   = "eu-central-h1"
+  name       = "web"
+}
+
+resource "ubicloud_firewall_attachment" "web" {
+  project_id        = ubicloud_firewall.web.project_id
+  location          = ubicloud_firewall.web.location
+  firewall_name     = ubicloud_firewall.web.name
+  private_subnet_id = ubicloud_private_subnet.web.id
+}
@@ -0,0 +1 @@
+terraform import ubicloud_postgres_firewall_rule.example <project_id>,<location>,<postgres_name>,<rule_id>
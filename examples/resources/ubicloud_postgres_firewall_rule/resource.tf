@@ -0,0 +1,6 @@
+resource "ubicloud_postgres_firewall_rule" "office" {
+  project_id    = "pj01qy4sty1j7nycv8hfqmgy6t"
+  location      = "eu-central-h1"
+  postgres_name = "pg-example"
+  cidr          = "203.0.113.0/24"
+}
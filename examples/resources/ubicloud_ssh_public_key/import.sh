@@ -0,0 +1 @@
+terraform import ubicloud_ssh_public_key.example <project_id>,<id>
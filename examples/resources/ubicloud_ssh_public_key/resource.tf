@@ -0,0 +1,28 @@
+variable "project_id" {
+  description = "Ubicloud project"
+  type        = string
+  default     = "pj01qy4sty1j7nycv8hfqmgy6t"
+}
+
+variable "location" {
+  description = "Ubicloud location"
+  type        = string
+  default     = "eu-central-h1"
+}
+
+resource "ubicloud_ssh_public_key" "team" {
+  project_id = var.project_id
+  name       = "team-key"
+  public_key = file("~/.ssh/id_ed25519.pub")
+}
+
+resource "ubicloud_vm" "example" {
+  project_id        = var.project_id
+  location          = var.location
+  name              = "vm-example"
+  ssh_public_key_id = ubicloud_ssh_public_key.team.id
+}
+
+output "team_key_fingerprint" {
+  value = ubicloud_ssh_public_key.team.public_key_fingerprint
+}
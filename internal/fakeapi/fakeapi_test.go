@@ -0,0 +1,346 @@
+package fakeapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/strictapi"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
+)
+
+const testLocation = "eu-central-h1"
+
+// newTestClient returns a client of the fake, failing the test for
+// responses that don't match the OpenAPI spec.
+func newTestClient(t *testing.T, s *Server) *ubicloud_client.ClientWithResponses {
+	t.Helper()
+
+	strictClient, err := strictapi.NewClient(nil, func(_ context.Context, err error) {
+		t.Errorf("response does not match the OpenAPI spec: %s", err)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := ubicloud_client.NewClientWithResponses(s.URL, ubicloud_client.WithHTTPClient(strictClient), ubicloud_client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+Token)
+		return nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func newTestServer(t *testing.T) (*Server, *ubicloud_client.ClientWithResponses, string) {
+	t.Helper()
+
+	s := NewServer()
+	t.Cleanup(s.Close)
+	return s, newTestClient(t, s), s.AddProject("test")
+}
+
+func TestUnauthorized(t *testing.T) {
+	s, _, projectId := newTestServer(t)
+
+	client, err := ubicloud_client.NewClientWithResponses(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.GetProjectWithResponse(context.Background(), projectId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusUnauthorized {
+		t.Errorf("expected 401 without token, got %d", resp.StatusCode())
+	}
+}
+
+func TestLogin(t *testing.T) {
+	s, _, _ := newTestServer(t)
+
+	client, err := ubicloud_client.NewClientWithResponses(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.LoginWithResponse(context.Background(), ubicloud_client.LoginJSONRequestBody{Login: "user@example.com", Password: "password"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.HTTPResponse.Header.Get("Authorization") != "Bearer "+Token {
+		t.Errorf("expected the token of the fake, got %d %q", resp.StatusCode(), resp.HTTPResponse.Header.Get("Authorization"))
+	}
+}
+
+func TestInvalidParameter(t *testing.T) {
+	s, _, projectId := newTestServer(t)
+
+	req, err := http.NewRequest(http.MethodGet, s.URL+"/project/"+projectId+"/vm?page_size=many", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+Token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected 400 for a page_size that is no integer, got %d", resp.StatusCode)
+	}
+}
+
+func TestVmTransitions(t *testing.T) {
+	s, client, projectId := newTestServer(t)
+	s.SetTransitionReads(2)
+	ctx := context.Background()
+
+	createResp, err := client.CreateVMWithResponse(ctx, projectId, testLocation, "test-vm", ubicloud_client.CreateVMJSONRequestBody{
+		PublicKey: ptr("ssh-ed25519 AAAA test"),
+		EnableIp4: ptr(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if createResp.StatusCode() != http.StatusOK {
+		t.Fatalf("unexpected status creating VM: %d %s", createResp.StatusCode(), createResp.Body)
+	}
+	vm := createResp.JSON200
+	if *vm.State != "creating" || *vm.Size != defaultVmSize || *vm.StorageSizeGib != 40 || vm.Ip4 == nil {
+		t.Errorf("unexpected created VM: %s", createResp.Body)
+	}
+	if len(*vm.Firewalls) != 1 || *vm.Subnet != "default-"+testLocation {
+		t.Errorf("expected VM in the default subnet with its firewall: %s", createResp.Body)
+	}
+
+	for i, expected := range []string{"creating", "creating", "running"} {
+		resp, err := client.GetVMDetailsWithResponse(ctx, projectId, testLocation, "test-vm")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *resp.JSON200.State != expected {
+			t.Errorf("read %d: expected state %s, got %s", i, expected, *resp.JSON200.State)
+		}
+	}
+
+	deleteResp, err := client.DeleteVMWithResponse(ctx, projectId, testLocation, "test-vm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleteResp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("unexpected status deleting VM: %d", deleteResp.StatusCode())
+	}
+
+	for i, expected := range []int{http.StatusOK, http.StatusOK, http.StatusNotFound} {
+		resp, err := client.GetVMDetailsWithResponse(ctx, projectId, testLocation, "test-vm")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != expected {
+			t.Errorf("read %d after delete: expected status %d, got %d", i, expected, resp.StatusCode())
+		}
+	}
+}
+
+func TestVmValidation(t *testing.T) {
+	_, client, projectId := newTestServer(t)
+	ctx := context.Background()
+
+	tests := map[string]ubicloud_client.CreateVMJSONRequestBody{
+		"no public key":    {},
+		"both public keys": {PublicKey: ptr("ssh-ed25519 AAAA test"), SshPublicKeyId: ptr("sk000000000000000000000001")},
+		"unknown key id":   {SshPublicKeyId: ptr("sk000000000000000000000001")},
+		"invalid size":     {PublicKey: ptr("ssh-ed25519 AAAA test"), Size: ptr("standard-3")},
+		"invalid image":    {PublicKey: ptr("ssh-ed25519 AAAA test"), BootImage: ptr("windows")},
+		"unknown subnet":   {PublicKey: ptr("ssh-ed25519 AAAA test"), PrivateSubnetId: ptr("ps000000000000000000000001")},
+	}
+
+	for name, body := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, err := client.CreateVMWithResponse(ctx, projectId, testLocation, "test-vm", body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode() != http.StatusBadRequest {
+				t.Errorf("expected 400, got %d %s", resp.StatusCode(), resp.Body)
+			}
+		})
+	}
+}
+
+func TestInjectError(t *testing.T) {
+	s, client, projectId := newTestServer(t)
+	ctx := context.Background()
+
+	s.InjectError(http.MethodGet, "/project/*", http.StatusServiceUnavailable, 0)
+	s.InjectError(http.MethodGet, "/project/*", http.StatusServiceUnavailable, 2)
+	for i, expected := range []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK} {
+		resp, err := client.GetProjectWithResponse(ctx, projectId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != expected {
+			t.Errorf("request %d: expected status %d, got %d", i, expected, resp.StatusCode())
+		}
+	}
+
+	s.InjectError("", "/project/*/location/*/vm/*", http.StatusInternalServerError, -1)
+	for range 3 {
+		resp, err := client.GetVMDetailsWithResponse(ctx, projectId, testLocation, "test-vm")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusInternalServerError {
+			t.Errorf("expected the error until cleared, got %d", resp.StatusCode())
+		}
+	}
+
+	s.ClearErrors()
+	resp, err := client.GetVMDetailsWithResponse(ctx, projectId, testLocation, "test-vm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusNotFound {
+		t.Errorf("expected 404 after clearing errors, got %d", resp.StatusCode())
+	}
+}
+
+func TestPagination(t *testing.T) {
+	s, client, projectId := newTestServer(t)
+	s.SetTransitionReads(0)
+	ctx := context.Background()
+
+	for i := range 5 {
+		resp, err := client.CreateVMWithResponse(ctx, projectId, testLocation, fmt.Sprintf("test-vm-%d", i), ubicloud_client.CreateVMJSONRequestBody{
+			PublicKey: ptr("ssh-ed25519 AAAA test"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			t.Fatalf("unexpected status creating VM: %d %s", resp.StatusCode(), resp.Body)
+		}
+	}
+
+	var names []string
+	params := &ubicloud_client.ListProjectVMsParams{PageSize: ptr(2)}
+	for {
+		resp, err := client.ListProjectVMsWithResponse(ctx, projectId, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *resp.JSON200.Count != 5 {
+			t.Errorf("expected count 5, got %d", *resp.JSON200.Count)
+		}
+		items := *resp.JSON200.Items
+		if len(items) == 0 {
+			break
+		}
+		if len(items) > 2 {
+			t.Fatalf("expected at most 2 items, got %d", len(items))
+		}
+		for _, vm := range items {
+			names = append(names, *vm.Name)
+		}
+		params.StartAfter = items[len(items)-1].Id
+	}
+
+	if fmt.Sprint(names) != "[test-vm-0 test-vm-1 test-vm-2 test-vm-3 test-vm-4]" {
+		t.Errorf("unexpected VMs: %v", names)
+	}
+}
+
+func TestPrivateSubnetWithVmsCannotBeDeleted(t *testing.T) {
+	s, client, projectId := newTestServer(t)
+	ctx := context.Background()
+
+	firewallId := s.AddFirewall(projectId, testLocation, "test-fw")
+	subnetId := s.AddPrivateSubnet(projectId, testLocation, "test-subnet", firewallId)
+	vmResp, err := client.CreateVMWithResponse(ctx, projectId, testLocation, "test-vm", ubicloud_client.CreateVMJSONRequestBody{
+		PublicKey:       ptr("ssh-ed25519 AAAA test"),
+		PrivateSubnetId: ptr(subnetId),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vmResp.StatusCode() != http.StatusOK {
+		t.Fatalf("unexpected status creating VM: %d %s", vmResp.StatusCode(), vmResp.Body)
+	}
+	if fws := *vmResp.JSON200.Firewalls; len(fws) != 1 || *fws[0].Id != firewallId {
+		t.Errorf("expected the VM to have the firewall of its subnet: %s", vmResp.Body)
+	}
+
+	resp, err := client.DeletePrivateSubnetWithResponse(ctx, projectId, testLocation, "test-subnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusConflict {
+		t.Errorf("expected 409 deleting a subnet with a VM, got %d", resp.StatusCode())
+	}
+
+	if _, err := client.DeleteVMWithResponse(ctx, projectId, testLocation, "test-vm"); err != nil {
+		t.Fatal(err)
+	}
+	resp, err = client.DeletePrivateSubnetWithResponse(ctx, projectId, testLocation, "test-subnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		t.Errorf("expected 204 deleting a subnet once its VM is deleted, got %d", resp.StatusCode())
+	}
+}
+
+func TestPostgresRestore(t *testing.T) {
+	s, client, projectId := newTestServer(t)
+	s.SetTransitionReads(0)
+	ctx := context.Background()
+
+	createResp, err := client.CreatePostgresDatabaseWithResponse(ctx, projectId, testLocation, "test-pg", ubicloud_client.CreatePostgresDatabaseJSONRequestBody{
+		Size: "standard-2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if createResp.StatusCode() != http.StatusOK {
+		t.Fatalf("unexpected status creating database: %d %s", createResp.StatusCode(), createResp.Body)
+	}
+	pg := createResp.JSON200
+	if *pg.Version != defaultPostgresVersion || *pg.HaType != defaultPostgresHaType || *pg.StorageSizeGib != 64 || len(*pg.FirewallRules) != 2 {
+		t.Errorf("unexpected created database: %s", createResp.Body)
+	}
+
+	getResp, err := client.GetPostgresDatabaseDetailsWithResponse(ctx, projectId, testLocation, "test-pg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *getResp.JSON200.State != "running" || getResp.JSON200.ConnectionString == nil {
+		t.Fatalf("expected a running database with connection string: %s", getResp.Body)
+	}
+
+	restoreResp, err := client.RestorePostgresDatabaseWithResponse(ctx, projectId, testLocation, "test-pg", ubicloud_client.RestorePostgresDatabaseJSONRequestBody{
+		Name:          "test-pg-restored",
+		RestoreTarget: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restoreResp.StatusCode() != http.StatusBadRequest {
+		t.Errorf("expected 400 restoring before the earliest restore time, got %d", restoreResp.StatusCode())
+	}
+
+	restoreResp, err = client.RestorePostgresDatabaseWithResponse(ctx, projectId, testLocation, "test-pg", ubicloud_client.RestorePostgresDatabaseJSONRequestBody{
+		Name:          "test-pg-restored",
+		RestoreTarget: *getResp.JSON200.LatestRestoreTime,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restoreResp.StatusCode() != http.StatusOK {
+		t.Fatalf("unexpected status restoring database: %d %s", restoreResp.StatusCode(), restoreResp.Body)
+	}
+	if *restoreResp.JSON200.Name != "test-pg-restored" || *restoreResp.JSON200.VmSize != "standard-2" {
+		t.Errorf("unexpected restored database: %s", restoreResp.Body)
+	}
+}
@@ -0,0 +1,313 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_server"
+)
+
+type firewall struct {
+	projectId   string
+	id          string
+	name        string
+	location    string
+	description string
+	rules       []ubicloud_server.FirewallRule
+	subnetIds   []string
+}
+
+// AddFirewall creates a firewall without rules and returns its id.
+func (s *Server) AddFirewall(projectId string, location string, name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addFirewall(projectId, location, name, "").id
+}
+
+func (s *Server) addFirewall(projectId string, location string, name string, description string) *firewall {
+	fw := &firewall{
+		projectId:   projectId,
+		id:          s.newId("fw"),
+		name:        name,
+		location:    location,
+		description: description,
+		rules:       []ubicloud_server.FirewallRule{},
+		subnetIds:   []string{},
+	}
+	s.firewalls[fw.id] = fw
+	return fw
+}
+
+func (s *Server) findFirewall(projectId string, location string, name string) *firewall {
+	for _, fw := range s.firewalls {
+		if fw.projectId == projectId && fw.location == location && fw.name == name {
+			return fw
+		}
+	}
+	return nil
+}
+
+// firewall returns the firewall named name, writing a not found error if
+// there is none.
+func (s *Server) firewall(w http.ResponseWriter, projectId string, location string, name string) (*firewall, bool) {
+	if _, ok := s.projectLocation(w, projectId, location); !ok {
+		return nil, false
+	}
+
+	fw := s.findFirewall(projectId, location, name)
+	if fw == nil {
+		writeNotFound(w)
+		return nil, false
+	}
+	return fw, true
+}
+
+func (fw *firewall) model() ubicloud_server.Firewall {
+	return ubicloud_server.Firewall{
+		Id:            ptr(fw.id),
+		Name:          ptr(fw.name),
+		Location:      ptr(fw.location),
+		Description:   ptr(fw.description),
+		FirewallRules: ptr(slices.Clone(fw.rules)),
+	}
+}
+
+// subnetFirewalls returns the firewalls attached to a private subnet.
+func (s *Server) subnetFirewalls(subnetId string) []ubicloud_server.Firewall {
+	firewalls := []ubicloud_server.Firewall{}
+	for _, fw := range s.firewalls {
+		if slices.Contains(fw.subnetIds, subnetId) {
+			firewalls = append(firewalls, fw.model())
+		}
+	}
+	sortById(firewalls, func(fw ubicloud_server.Firewall) string { return *fw.Id })
+	return firewalls
+}
+
+// ListLocationFirewalls lists the firewalls of a project in location.
+func (s *Server) ListLocationFirewalls(w http.ResponseWriter, r *http.Request, projectId string, location string, params ubicloud_server.ListLocationFirewallsParams) {
+	if _, ok := s.projectLocation(w, projectId, location); !ok {
+		return
+	}
+
+	firewalls := []ubicloud_server.Firewall{}
+	for _, fw := range s.firewalls {
+		if fw.projectId == projectId && fw.location == location {
+			firewalls = append(firewalls, fw.model())
+		}
+	}
+
+	page, err := paginate(firewalls, func(fw ubicloud_server.Firewall) string { return *fw.Id }, params.StartAfter, params.PageSize, params.OrderColumn)
+	writePage(w, page, err)
+}
+
+func (s *Server) CreateFirewall(w http.ResponseWriter, r *http.Request, projectId string, location string, firewallName string) {
+	if _, ok := s.projectLocation(w, projectId, location); !ok {
+		return
+	}
+
+	var body ubicloud_server.CreateFirewallJSONBody
+	if !readJSON(w, r, &body) || !validName(w, firewallName) {
+		return
+	}
+	if s.findFirewall(projectId, location, firewallName) != nil {
+		writeInvalidRequest(w, fmt.Sprintf("Firewall with name %q already exists", firewallName))
+		return
+	}
+
+	fw := s.addFirewall(projectId, location, firewallName, valueOrEmpty(body.Description))
+	writeJSON(w, http.StatusOK, fw.model())
+}
+
+func (s *Server) GetFirewallDetails(w http.ResponseWriter, r *http.Request, projectId string, location string, firewallName string) {
+	if fw, ok := s.firewall(w, projectId, location, firewallName); ok {
+		writeJSON(w, http.StatusOK, fw.model())
+	}
+}
+
+func (s *Server) UpdateFirewall(w http.ResponseWriter, r *http.Request, projectId string, location string, firewallName string) {
+	fw, ok := s.firewall(w, projectId, location, firewallName)
+	if !ok {
+		return
+	}
+
+	var body ubicloud_server.UpdateFirewallJSONBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+	if body.Description != nil {
+		fw.description = *body.Description
+	}
+	writeJSON(w, http.StatusOK, fw.model())
+}
+
+func (s *Server) DeleteFirewall(w http.ResponseWriter, r *http.Request, projectId string, location string, firewallName string) {
+	if _, ok := s.projectLocation(w, projectId, location); !ok {
+		return
+	}
+
+	if fw := s.findFirewall(projectId, location, firewallName); fw != nil {
+		delete(s.firewalls, fw.id)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) RenameFirewall(w http.ResponseWriter, r *http.Request, projectId string, location string, firewallName string) {
+	fw, ok := s.firewall(w, projectId, location, firewallName)
+	if !ok {
+		return
+	}
+
+	var body ubicloud_server.RenameFirewallJSONBody
+	if !readJSON(w, r, &body) || !validName(w, body.Name) {
+		return
+	}
+	if body.Name != fw.name && s.findFirewall(fw.projectId, fw.location, body.Name) != nil {
+		writeInvalidRequest(w, fmt.Sprintf("Firewall with name %q already exists", body.Name))
+		return
+	}
+
+	fw.name = body.Name
+	writeJSON(w, http.StatusOK, fw.model())
+}
+
+func (s *Server) AttachFirewallSubnet(w http.ResponseWriter, r *http.Request, projectId string, location string, firewallName string) {
+	fw, ok := s.firewall(w, projectId, location, firewallName)
+	if !ok {
+		return
+	}
+
+	var body ubicloud_server.AttachFirewallSubnetJSONBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+	ps, ok := s.subnets[body.PrivateSubnetId]
+	if !ok || ps.projectId != fw.projectId || ps.location != fw.location {
+		writeNotFound(w)
+		return
+	}
+
+	if !slices.Contains(fw.subnetIds, ps.id) {
+		fw.subnetIds = append(fw.subnetIds, ps.id)
+	}
+	writeJSON(w, http.StatusOK, fw.model())
+}
+
+func (s *Server) DetachFirewallSubnet(w http.ResponseWriter, r *http.Request, projectId string, location string, firewallName string) {
+	fw, ok := s.firewall(w, projectId, location, firewallName)
+	if !ok {
+		return
+	}
+
+	var body ubicloud_server.DetachFirewallSubnetJSONBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+	if _, ok := s.subnets[body.PrivateSubnetId]; !ok {
+		writeNotFound(w)
+		return
+	}
+
+	fw.subnetIds = slices.DeleteFunc(fw.subnetIds, func(id string) bool { return id == body.PrivateSubnetId })
+	writeJSON(w, http.StatusOK, fw.model())
+}
+
+func (s *Server) CreateFirewallRule(w http.ResponseWriter, r *http.Request, projectId string, location string, firewallName string) {
+	fw, ok := s.firewall(w, projectId, location, firewallName)
+	if !ok {
+		return
+	}
+
+	var body ubicloud_server.CreateFirewallRuleJSONBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+	cidr, err := normalizeCidr(body.Cidr)
+	if err != nil {
+		writeInvalidRequest(w, err.Error())
+		return
+	}
+	portRange, err := normalizePortRange(valueOrEmpty(body.PortRange))
+	if err != nil {
+		writeInvalidRequest(w, err.Error())
+		return
+	}
+
+	rule := ubicloud_server.FirewallRule{
+		Id:        ptr(s.newId("fr")),
+		Cidr:      ptr(cidr),
+		PortRange: ptr(portRange),
+	}
+	fw.rules = append(fw.rules, rule)
+	writeJSON(w, http.StatusOK, rule)
+}
+
+func (s *Server) GetFirewallRuleDetails(w http.ResponseWriter, r *http.Request, projectId string, location string, firewallName string, firewallRuleId string) {
+	fw, ok := s.firewall(w, projectId, location, firewallName)
+	if !ok {
+		return
+	}
+
+	i := slices.IndexFunc(fw.rules, func(rule ubicloud_server.FirewallRule) bool { return *rule.Id == firewallRuleId })
+	if i < 0 {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, fw.rules[i])
+}
+
+func (s *Server) DeleteFirewallRule(w http.ResponseWriter, r *http.Request, projectId string, location string, firewallName string, firewallRuleId string) {
+	fw, ok := s.firewall(w, projectId, location, firewallName)
+	if !ok {
+		return
+	}
+
+	fw.rules = slices.DeleteFunc(fw.rules, func(rule ubicloud_server.FirewallRule) bool { return *rule.Id == firewallRuleId })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// normalizeCidr parses a CIDR or address and returns it with host bits
+// cleared, as the Ubicloud API stores it.
+func normalizeCidr(value string) (string, error) {
+	if addr, err := netip.ParseAddr(value); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()).String(), nil
+	}
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return "", fmt.Errorf("Invalid CIDR %q", value)
+	}
+	return prefix.Masked().String(), nil
+}
+
+// normalizePortRange parses a port or port range and returns it in the
+// start..end format of the Ubicloud API. An empty value means all ports.
+func normalizePortRange(value string) (string, error) {
+	if value == "" {
+		return "0..65535", nil
+	}
+
+	startValue, endValue, isRange := strings.Cut(value, "..")
+	if !isRange {
+		endValue = startValue
+	}
+	start, err := strconv.Atoi(startValue)
+	if err != nil {
+		return "", fmt.Errorf("Invalid port range %q", value)
+	}
+	end, err := strconv.Atoi(endValue)
+	if err != nil || start < 0 || end > 65535 || start > end {
+		return "", fmt.Errorf("Invalid port range %q", value)
+	}
+	return fmt.Sprintf("%d..%d", start, end), nil
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,65 @@
+package fakeapi
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_server"
+)
+
+// Locations are the locations served by the fake, with the options
+// available in them.
+var Locations = []ubicloud_server.LocationCatalog{
+	newLocation("eu-central-h1", "Germany"),
+	newLocation("eu-north-h1", "Finland"),
+	newLocation("us-east-a2", "Virginia, US"),
+}
+
+func newLocation(name string, displayName string) ubicloud_server.LocationCatalog {
+	return ubicloud_server.LocationCatalog{
+		Name:             ptr(name),
+		DisplayName:      ptr(displayName),
+		VmSizes:          ptr([]string{"standard-2", "standard-4", "standard-8", "standard-16", "standard-30", "standard-60", "burstable-1", "burstable-2"}),
+		BootImages:       ptr([]string{"ubuntu-noble", "ubuntu-jammy", "debian-12", "almalinux-9"}),
+		PostgresSizes:    ptr([]string{"standard-2", "standard-4", "standard-8", "standard-16", "standard-30", "standard-60", "burstable-1", "burstable-2"}),
+		PostgresHaTypes:  ptr([]string{"none", "async", "sync"}),
+		PostgresVersions: ptr([]string{"16", "17"}),
+	}
+}
+
+func (s *Server) ListLocations(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, listResponse[ubicloud_server.LocationCatalog]{Items: Locations, Count: len(Locations)})
+}
+
+// findLocation returns the location named name.
+func findLocation(name string) (*ubicloud_server.LocationCatalog, bool) {
+	i := slices.IndexFunc(Locations, func(l ubicloud_server.LocationCatalog) bool { return *l.Name == name })
+	if i < 0 {
+		return nil, false
+	}
+	return &Locations[i], true
+}
+
+// projectLocation returns the location named location, writing a not found
+// error if either it or the project does not exist.
+func (s *Server) projectLocation(w http.ResponseWriter, projectId string, location string) (*ubicloud_server.LocationCatalog, bool) {
+	if _, ok := s.project(w, projectId); !ok {
+		return nil, false
+	}
+
+	catalog, ok := findLocation(location)
+	if !ok {
+		writeError(w, http.StatusNotFound, "ResourceNotFound", "Validation failed for following path components: location")
+		return nil, false
+	}
+	return catalog, true
+}
+
+// validOption checks that value is one of the options of a location.
+func validOption(w http.ResponseWriter, kind string, value string, options *[]string) bool {
+	if !slices.Contains(*options, value) {
+		writeInvalidRequest(w, "Invalid "+kind+" "+value)
+		return false
+	}
+	return true
+}
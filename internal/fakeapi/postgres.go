@@ -0,0 +1,376 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_server"
+)
+
+const (
+	defaultPostgresVersion = "17"
+	defaultPostgresHaType  = "none"
+)
+
+type postgres struct {
+	projectId     string
+	id            string
+	name          string
+	location      string
+	vmSize        string
+	storageSize   int
+	haType        string
+	version       string
+	password      string
+	createdAt     time.Time
+	firewallRules []ubicloud_server.PostgresFirewallRule
+	state         transition
+}
+
+// findPostgres returns the database named name. A database being deleted is
+// only returned if there is no other database with this name.
+func (s *Server) findPostgres(projectId string, location string, name string) *postgres {
+	var found *postgres
+	for _, pg := range s.sortedPostgres() {
+		if pg.projectId == projectId && pg.location == location && pg.name == name {
+			if pg.state.state != "deleting" {
+				return pg
+			}
+			found = pg
+		}
+	}
+	return found
+}
+
+func (s *Server) sortedPostgres() []*postgres {
+	databases := make([]*postgres, 0, len(s.postgres))
+	for _, pg := range s.postgres {
+		databases = append(databases, pg)
+	}
+	sortById(databases, func(pg *postgres) string { return pg.id })
+	return databases
+}
+
+// postgresDatabase returns the database named name or, if name is empty,
+// with id id. It returns nil if there is none, after writing a not found
+// error if notFound is set.
+func (s *Server) postgresDatabase(w http.ResponseWriter, projectId string, location string, name string, id string, notFound bool) (*postgres, bool) {
+	if _, ok := s.projectLocation(w, projectId, location); !ok {
+		return nil, false
+	}
+
+	var found *postgres
+	if name == "" {
+		if candidate, ok := s.postgres[id]; ok && candidate.projectId == projectId && candidate.location == location {
+			found = candidate
+		}
+	} else {
+		found = s.findPostgres(projectId, location, name)
+	}
+
+	if found == nil && notFound {
+		writeNotFound(w)
+	}
+	return found, found != nil || !notFound
+}
+
+func (pg *postgres) model() ubicloud_server.Postgres {
+	return ubicloud_server.Postgres{
+		Id:             ptr(pg.id),
+		Name:           ptr(pg.name),
+		State:          ptr(pg.state.state),
+		Location:       ptr(pg.location),
+		VmSize:         ptr(pg.vmSize),
+		StorageSizeGib: ptr(pg.storageSize),
+		HaType:         ptr(pg.haType),
+		Version:        ptr(pg.version),
+	}
+}
+
+func (pg *postgres) detailedModel() ubicloud_server.PostgresDetailed {
+	model := ubicloud_server.PostgresDetailed{
+		Id:             ptr(pg.id),
+		Name:           ptr(pg.name),
+		State:          ptr(pg.state.state),
+		Location:       ptr(pg.location),
+		VmSize:         ptr(pg.vmSize),
+		StorageSizeGib: ptr(pg.storageSize),
+		HaType:         ptr(pg.haType),
+		Version:        ptr(pg.version),
+		Primary:        ptr(true),
+		FirewallRules:  ptr(slices.Clone(pg.firewallRules)),
+	}
+	if pg.state.state == "running" {
+		model.ConnectionString = ptr(fmt.Sprintf("postgres://postgres:%s@%s.%s.postgres.example.com/postgres?channel_binding=require", pg.password, pg.name, pg.id))
+		model.EarliestRestoreTime = ptr(pg.createdAt.Format(time.RFC3339))
+		model.LatestRestoreTime = ptr(time.Now().UTC().Format(time.RFC3339))
+	}
+	return model
+}
+
+func (s *Server) ListPostgresDatabases(w http.ResponseWriter, r *http.Request, projectId string, params ubicloud_server.ListPostgresDatabasesParams) {
+	s.listPostgres(w, projectId, "", params.StartAfter, params.PageSize, params.OrderColumn)
+}
+
+func (s *Server) ListLocationPostgresDatabases(w http.ResponseWriter, r *http.Request, projectId string, location string, params ubicloud_server.ListLocationPostgresDatabasesParams) {
+	s.listPostgres(w, projectId, location, params.StartAfter, params.PageSize, params.OrderColumn)
+}
+
+// listPostgres lists the databases of a project, in location unless it is
+// empty.
+func (s *Server) listPostgres(w http.ResponseWriter, projectId string, location string, startAfter *string, pageSize *int, orderColumn *string) {
+	if _, ok := s.project(w, projectId); !ok {
+		return
+	}
+
+	databases := []ubicloud_server.Postgres{}
+	for _, pg := range s.postgres {
+		if pg.projectId == projectId && (location == "" || pg.location == location) {
+			databases = append(databases, pg.model())
+		}
+	}
+
+	page, err := paginate(databases, func(pg ubicloud_server.Postgres) string { return *pg.Id }, startAfter, pageSize, orderColumn)
+	writePage(w, page, err)
+}
+
+// addPostgres creates a running or creating database with the firewall
+// rules allowing all traffic the Ubicloud API creates.
+func (s *Server) addPostgres(projectId string, location string, name string, size string, storageSize int, haType string, version string) *postgres {
+	pg := &postgres{
+		projectId:   projectId,
+		id:          s.newId("pg"),
+		name:        name,
+		location:    location,
+		vmSize:      size,
+		storageSize: storageSize,
+		haType:      haType,
+		version:     version,
+		password:    fmt.Sprintf("password%d", s.nextId),
+		createdAt:   time.Now().UTC(),
+		state:       s.newTransition("creating"),
+	}
+	for _, cidr := range []string{"0.0.0.0/0", "::/0"} {
+		pg.firewallRules = append(pg.firewallRules, ubicloud_server.PostgresFirewallRule{
+			Id:   ptr(s.newId("fr")),
+			Cidr: ptr(cidr),
+		})
+	}
+	s.postgres[pg.id] = pg
+	return pg
+}
+
+func (s *Server) CreatePostgresDatabase(w http.ResponseWriter, r *http.Request, projectId string, location string, postgresDatabaseName string) {
+	catalog, ok := s.projectLocation(w, projectId, location)
+	if !ok {
+		return
+	}
+
+	var body ubicloud_server.CreatePostgresDatabaseJSONBody
+	if !readJSON(w, r, &body) || !validName(w, postgresDatabaseName) {
+		return
+	}
+	if existing := s.findPostgres(projectId, location, postgresDatabaseName); existing != nil && existing.state.state != "deleting" {
+		writeInvalidRequest(w, fmt.Sprintf("Postgres database with name %q already exists", postgresDatabaseName))
+		return
+	}
+
+	haType := defaultPostgresHaType
+	if body.HaType != nil {
+		haType = *body.HaType
+	}
+	version := defaultPostgresVersion
+	if body.Version != nil {
+		version = *body.Version
+	}
+	if !validOption(w, "size", body.Size, catalog.PostgresSizes) ||
+		!validOption(w, "ha_type", haType, catalog.PostgresHaTypes) ||
+		!validOption(w, "version", version, catalog.PostgresVersions) {
+		return
+	}
+
+	// As the provider sends 0 for an unset storage_size, it means the default.
+	storageSize := defaultStorageSize(body.Size) * 8 / 5
+	if body.StorageSize != nil && *body.StorageSize != 0 {
+		storageSize = *body.StorageSize
+	}
+	if storageSize < 0 {
+		writeInvalidRequest(w, fmt.Sprintf("Invalid storage_size %d", storageSize))
+		return
+	}
+
+	pg := s.addPostgres(projectId, location, postgresDatabaseName, body.Size, storageSize, haType, version)
+	writeJSON(w, http.StatusOK, pg.detailedModel())
+}
+
+func (s *Server) GetPostgresDatabaseDetails(w http.ResponseWriter, r *http.Request, projectId string, location string, postgresDatabaseName string) {
+	if pg, ok := s.postgresDatabase(w, projectId, location, postgresDatabaseName, "", true); ok {
+		s.getPostgres(w, pg)
+	}
+}
+
+func (s *Server) GetPostgresDetailsWithId(w http.ResponseWriter, r *http.Request, projectId string, location string, postgresDatabaseId string) {
+	if pg, ok := s.postgresDatabase(w, projectId, location, "", postgresDatabaseId, true); ok {
+		s.getPostgres(w, pg)
+	}
+}
+
+func (s *Server) getPostgres(w http.ResponseWriter, pg *postgres) {
+	if !pg.state.read() {
+		delete(s.postgres, pg.id)
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, pg.detailedModel())
+}
+
+func (s *Server) DeletePostgresDatabase(w http.ResponseWriter, r *http.Request, projectId string, location string, postgresDatabaseName string) {
+	if pg, ok := s.postgresDatabase(w, projectId, location, postgresDatabaseName, "", false); ok {
+		s.deletePostgres(w, pg)
+	}
+}
+
+func (s *Server) DeletePostgresDatabaseWithID(w http.ResponseWriter, r *http.Request, projectId string, location string, postgresDatabaseId string) {
+	if pg, ok := s.postgresDatabase(w, projectId, location, "", postgresDatabaseId, false); ok {
+		s.deletePostgres(w, pg)
+	}
+}
+
+func (s *Server) deletePostgres(w http.ResponseWriter, pg *postgres) {
+	if pg != nil && pg.state.state != "deleting" {
+		pg.state = s.newTransition("deleting")
+		if pg.state.remaining == 0 {
+			delete(s.postgres, pg.id)
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) RestorePostgresDatabase(w http.ResponseWriter, r *http.Request, projectId string, location string, postgresDatabaseName string) {
+	if pg, ok := s.postgresDatabase(w, projectId, location, postgresDatabaseName, "", true); ok {
+		if restored, ok := s.restorePostgres(w, r, pg); ok {
+			writeJSON(w, http.StatusOK, restored.detailedModel())
+		}
+	}
+}
+
+// RestorePostgresDatabaseWithID restores like RestorePostgresDatabase, but
+// responds with the database without details, as in the spec.
+func (s *Server) RestorePostgresDatabaseWithID(w http.ResponseWriter, r *http.Request, projectId string, location string, postgresDatabaseId string) {
+	if pg, ok := s.postgresDatabase(w, projectId, location, "", postgresDatabaseId, true); ok {
+		if restored, ok := s.restorePostgres(w, r, pg); ok {
+			writeJSON(w, http.StatusOK, restored.model())
+		}
+	}
+}
+
+// restorePostgres creates a database with the settings of pg. The restore
+// target must be within the restore window of pg.
+func (s *Server) restorePostgres(w http.ResponseWriter, r *http.Request, pg *postgres) (*postgres, bool) {
+	var body ubicloud_server.RestorePostgresDatabaseJSONBody
+	if !readJSON(w, r, &body) {
+		return nil, false
+	}
+	if body.Name == "" || body.RestoreTarget == "" {
+		writeInvalidRequest(w, "name and restore_target are required")
+		return nil, false
+	}
+	if !validName(w, body.Name) {
+		return nil, false
+	}
+	if existing := s.findPostgres(pg.projectId, pg.location, body.Name); existing != nil && existing.state.state != "deleting" {
+		writeInvalidRequest(w, fmt.Sprintf("Postgres database with name %q already exists", body.Name))
+		return nil, false
+	}
+	target, err := time.Parse(time.RFC3339, body.RestoreTarget)
+	if err != nil || pg.state.state != "running" || target.Before(pg.createdAt.Truncate(time.Second)) || target.After(time.Now()) {
+		writeInvalidRequest(w, fmt.Sprintf("Restore target must be between the earliest and latest restore time, got %q", body.RestoreTarget))
+		return nil, false
+	}
+
+	return s.addPostgres(pg.projectId, pg.location, body.Name, pg.vmSize, pg.storageSize, pg.haType, pg.version), true
+}
+
+func (s *Server) ResetSuperuserPassword(w http.ResponseWriter, r *http.Request, projectId string, location string, postgresDatabaseName string) {
+	if pg, ok := s.postgresDatabase(w, projectId, location, postgresDatabaseName, "", true); ok {
+		s.resetSuperuserPassword(w, r, pg)
+	}
+}
+
+func (s *Server) ResetSuperuserPasswordWithID(w http.ResponseWriter, r *http.Request, projectId string, location string, postgresDatabaseId string) {
+	if pg, ok := s.postgresDatabase(w, projectId, location, "", postgresDatabaseId, true); ok {
+		s.resetSuperuserPassword(w, r, pg)
+	}
+}
+
+func (s *Server) resetSuperuserPassword(w http.ResponseWriter, r *http.Request, pg *postgres) {
+	var body ubicloud_server.ResetSuperuserPasswordJSONBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+	if len(body.Password) < 12 {
+		writeInvalidRequest(w, "Password must have 12 characters minimum.")
+		return
+	}
+	if pg.state.state != "running" {
+		writeInvalidRequest(w, "Superuser password cannot be updated during initial provisioning.")
+		return
+	}
+
+	pg.password = body.Password
+	writeJSON(w, http.StatusOK, pg.detailedModel())
+}
+
+func (s *Server) CreatePostgresFirewallRule(w http.ResponseWriter, r *http.Request, projectId string, location string, postgresDatabaseName string) {
+	pg, ok := s.postgresDatabase(w, projectId, location, postgresDatabaseName, "", true)
+	if !ok {
+		return
+	}
+
+	var body ubicloud_server.CreatePostgresFirewallRuleJSONBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+	cidr, err := normalizeCidr(body.Cidr)
+	if err != nil {
+		writeInvalidRequest(w, err.Error())
+		return
+	}
+
+	rule := ubicloud_server.PostgresFirewallRule{
+		Id:   ptr(s.newId("fr")),
+		Cidr: ptr(cidr),
+	}
+	pg.firewallRules = append(pg.firewallRules, rule)
+	writeJSON(w, http.StatusOK, rule)
+}
+
+func (s *Server) GetPostgresFirewallRuleDetails(w http.ResponseWriter, r *http.Request, projectId string, location string, postgresDatabaseName string, firewallRuleId string) {
+	pg, ok := s.postgresDatabase(w, projectId, location, postgresDatabaseName, "", true)
+	if !ok {
+		return
+	}
+
+	i := slices.IndexFunc(pg.firewallRules, func(rule ubicloud_server.PostgresFirewallRule) bool {
+		return *rule.Id == firewallRuleId
+	})
+	if i < 0 {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, pg.firewallRules[i])
+}
+
+func (s *Server) DeletePostgresFirewallRule(w http.ResponseWriter, r *http.Request, projectId string, location string, postgresDatabaseName string, firewallRuleId string) {
+	pg, ok := s.postgresDatabase(w, projectId, location, postgresDatabaseName, "", true)
+	if !ok {
+		return
+	}
+
+	pg.firewallRules = slices.DeleteFunc(pg.firewallRules, func(rule ubicloud_server.PostgresFirewallRule) bool {
+		return *rule.Id == firewallRuleId
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,213 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_server"
+)
+
+type privateSubnet struct {
+	projectId string
+	id        string
+	name      string
+	location  string
+	net4      string
+	net6      string
+	// hosts is the number of addresses handed out to VMs.
+	hosts int
+}
+
+// AddPrivateSubnet creates a private subnet and returns its id. The subnet
+// is attached to the firewall with id firewallId, unless it is empty.
+func (s *Server) AddPrivateSubnet(projectId string, location string, name string, firewallId string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ps := s.addPrivateSubnet(projectId, location, name)
+	if fw, ok := s.firewalls[firewallId]; ok {
+		fw.subnetIds = append(fw.subnetIds, ps.id)
+	}
+	return ps.id
+}
+
+func (s *Server) addPrivateSubnet(projectId string, location string, name string) *privateSubnet {
+	s.subnetCount++
+	ps := &privateSubnet{
+		projectId: projectId,
+		id:        s.newId("ps"),
+		name:      name,
+		location:  location,
+		net4:      fmt.Sprintf("10.%d.%d.0/26", s.subnetCount/256, s.subnetCount%256),
+		net6:      fmt.Sprintf("fd10:0:0:%x::/64", s.subnetCount),
+	}
+	s.subnets[ps.id] = ps
+	return ps
+}
+
+func (s *Server) findPrivateSubnet(projectId string, location string, name string) *privateSubnet {
+	for _, ps := range s.subnets {
+		if ps.projectId == projectId && ps.location == location && ps.name == name {
+			return ps
+		}
+	}
+	return nil
+}
+
+// privateSubnet returns the subnet named name or, if name is empty, with id
+// id. It returns nil if there is none, after writing a not found error if
+// notFound is set.
+func (s *Server) privateSubnet(w http.ResponseWriter, projectId string, location string, name string, id string, notFound bool) (*privateSubnet, bool) {
+	if _, ok := s.projectLocation(w, projectId, location); !ok {
+		return nil, false
+	}
+
+	var ps *privateSubnet
+	if name == "" {
+		if candidate, ok := s.subnets[id]; ok && candidate.projectId == projectId && candidate.location == location {
+			ps = candidate
+		}
+	} else {
+		ps = s.findPrivateSubnet(projectId, location, name)
+	}
+
+	if ps == nil && notFound {
+		writeNotFound(w)
+	}
+	return ps, ps != nil || !notFound
+}
+
+func (s *Server) privateSubnetModel(ps *privateSubnet) ubicloud_server.PrivateSubnet {
+	nics := []ubicloud_server.Nic{}
+	for _, vm := range s.sortedVms() {
+		if vm.subnetId == ps.id {
+			nics = append(nics, vm.nic())
+		}
+	}
+
+	return ubicloud_server.PrivateSubnet{
+		Id:        ptr(ps.id),
+		Name:      ptr(ps.name),
+		Location:  ptr(ps.location),
+		Net4:      ptr(ps.net4),
+		Net6:      ptr(ps.net6),
+		Nics:      &nics,
+		Firewalls: ptr(s.subnetFirewalls(ps.id)),
+	}
+}
+
+func (s *Server) ListPSs(w http.ResponseWriter, r *http.Request, projectId string, params ubicloud_server.ListPSsParams) {
+	s.listPrivateSubnets(w, projectId, "", params.StartAfter, params.PageSize, params.OrderColumn)
+}
+
+func (s *Server) ListLocationPrivateSubnets(w http.ResponseWriter, r *http.Request, projectId string, location string, params ubicloud_server.ListLocationPrivateSubnetsParams) {
+	s.listPrivateSubnets(w, projectId, location, params.StartAfter, params.PageSize, params.OrderColumn)
+}
+
+// listPrivateSubnets lists the subnets of a project, in location unless it
+// is empty.
+func (s *Server) listPrivateSubnets(w http.ResponseWriter, projectId string, location string, startAfter *string, pageSize *int, orderColumn *string) {
+	if _, ok := s.project(w, projectId); !ok {
+		return
+	}
+
+	subnets := []ubicloud_server.PrivateSubnet{}
+	for _, ps := range s.subnets {
+		if ps.projectId == projectId && (location == "" || ps.location == location) {
+			subnets = append(subnets, s.privateSubnetModel(ps))
+		}
+	}
+
+	page, err := paginate(subnets, func(ps ubicloud_server.PrivateSubnet) string { return *ps.Id }, startAfter, pageSize, orderColumn)
+	writePage(w, page, err)
+}
+
+// CreatePrivateSubnet creates a subnet. Without firewall_id, a firewall
+// allowing all traffic is created for the subnet, as in the Ubicloud API.
+func (s *Server) CreatePrivateSubnet(w http.ResponseWriter, r *http.Request, projectId string, location string, privateSubnetName string) {
+	if _, ok := s.projectLocation(w, projectId, location); !ok {
+		return
+	}
+
+	var body ubicloud_server.CreatePrivateSubnetJSONBody
+	if !readJSON(w, r, &body) || !validName(w, privateSubnetName) {
+		return
+	}
+	if s.findPrivateSubnet(projectId, location, privateSubnetName) != nil {
+		writeInvalidRequest(w, fmt.Sprintf("Private subnet with name %q already exists", privateSubnetName))
+		return
+	}
+
+	var fw *firewall
+	if body.FirewallId != nil {
+		fw = s.firewalls[*body.FirewallId]
+		if fw == nil || fw.projectId != projectId || fw.location != location {
+			writeInvalidRequest(w, fmt.Sprintf("Firewall with id %q and location %q does not exist", *body.FirewallId, location))
+			return
+		}
+	}
+
+	ps := s.addPrivateSubnet(projectId, location, privateSubnetName)
+	if fw == nil {
+		fw = s.addDefaultFirewall(ps)
+	}
+	fw.subnetIds = append(fw.subnetIds, ps.id)
+	writeJSON(w, http.StatusOK, s.privateSubnetModel(ps))
+}
+
+func (s *Server) addDefaultFirewall(ps *privateSubnet) *firewall {
+	fw := s.addFirewall(ps.projectId, ps.location, ps.name+"-default", fmt.Sprintf("Default firewall of %s", ps.name))
+	for _, cidr := range []string{"0.0.0.0/0", "::/0"} {
+		fw.rules = append(fw.rules, ubicloud_server.FirewallRule{
+			Id:        ptr(s.newId("fr")),
+			Cidr:      ptr(cidr),
+			PortRange: ptr("0..65535"),
+		})
+	}
+	return fw
+}
+
+func (s *Server) GetPrivateSubnetDetails(w http.ResponseWriter, r *http.Request, projectId string, location string, privateSubnetName string) {
+	if ps, ok := s.privateSubnet(w, projectId, location, privateSubnetName, "", true); ok {
+		writeJSON(w, http.StatusOK, s.privateSubnetModel(ps))
+	}
+}
+
+func (s *Server) GetPSDetailsWithId(w http.ResponseWriter, r *http.Request, projectId string, location string, privateSubnetId string) {
+	if ps, ok := s.privateSubnet(w, projectId, location, "", privateSubnetId, true); ok {
+		writeJSON(w, http.StatusOK, s.privateSubnetModel(ps))
+	}
+}
+
+func (s *Server) DeletePrivateSubnet(w http.ResponseWriter, r *http.Request, projectId string, location string, privateSubnetName string) {
+	if ps, ok := s.privateSubnet(w, projectId, location, privateSubnetName, "", false); ok {
+		s.deletePrivateSubnet(w, ps)
+	}
+}
+
+func (s *Server) DeletePSWithId(w http.ResponseWriter, r *http.Request, projectId string, location string, privateSubnetId string) {
+	if ps, ok := s.privateSubnet(w, projectId, location, "", privateSubnetId, false); ok {
+		s.deletePrivateSubnet(w, ps)
+	}
+}
+
+// deletePrivateSubnet deletes a subnet and detaches its firewalls. As in the
+// Ubicloud API, subnets with VMs can't be deleted. VMs being deleted are
+// ignored, as the provider does not wait for them to be gone.
+func (s *Server) deletePrivateSubnet(w http.ResponseWriter, ps *privateSubnet) {
+	if ps != nil {
+		for _, vm := range s.vms {
+			if vm.subnetId == ps.id && vm.state.state != "deleting" {
+				writeError(w, http.StatusConflict, "DependencyError", "Private subnet has VMs attached, first, delete them.")
+				return
+			}
+		}
+
+		for _, fw := range s.firewalls {
+			fw.subnetIds = slices.DeleteFunc(fw.subnetIds, func(id string) bool { return id == ps.id })
+		}
+		delete(s.subnets, ps.id)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,113 @@
+package fakeapi
+
+import (
+	"net/http"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_server"
+)
+
+// AddProject creates a project and returns its id.
+func (s *Server) AddProject(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addProject(name)
+}
+
+// SetProjectDiscount sets the discount of a project in percent.
+func (s *Server) SetProjectDiscount(projectId string, discount int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if project, ok := s.projects[projectId]; ok {
+		project.Discount = ptr(discount)
+	}
+}
+
+func (s *Server) addProject(name string) string {
+	id := s.newId("pj")
+	s.projects[id] = &ubicloud_server.Project{
+		Id:       ptr(id),
+		Name:     ptr(name),
+		Credit:   ptr(float32(0)),
+		Discount: ptr(0),
+	}
+	return id
+}
+
+// project returns the project with id projectId, writing a not found error
+// if there is none.
+func (s *Server) project(w http.ResponseWriter, projectId string) (*ubicloud_server.Project, bool) {
+	project, ok := s.projects[projectId]
+	if !ok {
+		writeNotFound(w)
+	}
+	return project, ok
+}
+
+func (s *Server) ListProjects(w http.ResponseWriter, r *http.Request, params ubicloud_server.ListProjectsParams) {
+	projects := []ubicloud_server.Project{}
+	for _, project := range s.projects {
+		projects = append(projects, *project)
+	}
+
+	page, err := paginate(projects, func(p ubicloud_server.Project) string { return *p.Id }, params.StartAfter, params.PageSize, params.OrderColumn)
+	writePage(w, page, err)
+}
+
+func (s *Server) CreateProject(w http.ResponseWriter, r *http.Request) {
+	var body ubicloud_server.CreateProjectJSONBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+	if body.Name == "" {
+		writeInvalidRequest(w, "Name is required")
+		return
+	}
+
+	id := s.addProject(body.Name)
+	writeJSON(w, http.StatusOK, s.projects[id])
+}
+
+func (s *Server) GetProject(w http.ResponseWriter, r *http.Request, projectId string) {
+	if project, ok := s.project(w, projectId); ok {
+		writeJSON(w, http.StatusOK, project)
+	}
+}
+
+// DeleteProject deletes a project. As in the Ubicloud API, projects that
+// still have resources can't be deleted.
+func (s *Server) DeleteProject(w http.ResponseWriter, r *http.Request, projectId string) {
+	if _, ok := s.project(w, projectId); !ok {
+		return
+	}
+
+	for _, vm := range s.vms {
+		if vm.projectId == projectId {
+			writeError(w, http.StatusBadRequest, "DependencyError", "'project' has some resources. Delete all related resources first.")
+			return
+		}
+	}
+	for _, pg := range s.postgres {
+		if pg.projectId == projectId {
+			writeError(w, http.StatusBadRequest, "DependencyError", "'project' has some resources. Delete all related resources first.")
+			return
+		}
+	}
+
+	delete(s.projects, projectId)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Login accepts any credentials and returns the token of the fake.
+func (s *Server) Login(w http.ResponseWriter, r *http.Request) {
+	var body ubicloud_server.LoginJSONBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+	if body.Login == "" || body.Password == "" {
+		writeError(w, http.StatusUnauthorized, "UnauthorizedError", "There was an error logging in")
+		return
+	}
+
+	w.Header().Set("Authorization", "Bearer "+Token)
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
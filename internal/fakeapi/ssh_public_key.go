@@ -0,0 +1,116 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_server"
+)
+
+type sshPublicKey struct {
+	projectId string
+	ubicloud_server.SshPublicKey
+}
+
+func (s *Server) sshPublicKey(w http.ResponseWriter, projectId string, sshPublicKeyId string) (*sshPublicKey, bool) {
+	key, ok := s.sshPublicKeys[sshPublicKeyId]
+	if !ok || key.projectId != projectId {
+		writeNotFound(w)
+		return nil, false
+	}
+	return key, true
+}
+
+func (s *Server) sshPublicKeyNameTaken(projectId string, name string) bool {
+	for _, key := range s.sshPublicKeys {
+		if key.projectId == projectId && *key.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) ListSshPublicKeys(w http.ResponseWriter, r *http.Request, projectId string) {
+	if _, ok := s.project(w, projectId); !ok {
+		return
+	}
+
+	keys := []ubicloud_server.SshPublicKey{}
+	for _, key := range s.sshPublicKeys {
+		if key.projectId == projectId {
+			keys = append(keys, key.SshPublicKey)
+		}
+	}
+	writeJSON(w, http.StatusOK, listResponse[ubicloud_server.SshPublicKey]{Items: keys, Count: len(keys)})
+}
+
+func (s *Server) CreateSshPublicKey(w http.ResponseWriter, r *http.Request, projectId string) {
+	project, ok := s.project(w, projectId)
+	if !ok {
+		return
+	}
+
+	var body ubicloud_server.CreateSshPublicKeyJSONBody
+	if !readJSON(w, r, &body) || !validName(w, body.Name) {
+		return
+	}
+	if body.PublicKey == "" {
+		writeInvalidRequest(w, "public_key is required")
+		return
+	}
+	if s.sshPublicKeyNameTaken(*project.Id, body.Name) {
+		writeInvalidRequest(w, fmt.Sprintf("SSH public key with name %q already exists", body.Name))
+		return
+	}
+
+	key := &sshPublicKey{
+		projectId: *project.Id,
+		SshPublicKey: ubicloud_server.SshPublicKey{
+			Id:        ptr(s.newId("sk")),
+			Name:      ptr(body.Name),
+			PublicKey: ptr(body.PublicKey),
+		},
+	}
+	s.sshPublicKeys[*key.Id] = key
+	writeJSON(w, http.StatusOK, key.SshPublicKey)
+}
+
+func (s *Server) GetSshPublicKeyDetails(w http.ResponseWriter, r *http.Request, projectId string, sshPublicKeyId string) {
+	if key, ok := s.sshPublicKey(w, projectId, sshPublicKeyId); ok {
+		writeJSON(w, http.StatusOK, key.SshPublicKey)
+	}
+}
+
+func (s *Server) UpdateSshPublicKey(w http.ResponseWriter, r *http.Request, projectId string, sshPublicKeyId string) {
+	key, ok := s.sshPublicKey(w, projectId, sshPublicKeyId)
+	if !ok {
+		return
+	}
+
+	var body ubicloud_server.UpdateSshPublicKeyJSONBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+	if body.Name != nil && *body.Name != *key.Name {
+		if !validName(w, *body.Name) {
+			return
+		}
+		if s.sshPublicKeyNameTaken(key.projectId, *body.Name) {
+			writeInvalidRequest(w, fmt.Sprintf("SSH public key with name %q already exists", *body.Name))
+			return
+		}
+		key.Name = body.Name
+	}
+	if body.PublicKey != nil {
+		key.PublicKey = body.PublicKey
+	}
+	writeJSON(w, http.StatusOK, key.SshPublicKey)
+}
+
+func (s *Server) DeleteSshPublicKey(w http.ResponseWriter, r *http.Request, projectId string, sshPublicKeyId string) {
+	// Deleting is idempotent, as in the Ubicloud API.
+	if key, ok := s.sshPublicKeys[sshPublicKeyId]; ok && key.projectId == projectId {
+		delete(s.sshPublicKeys, *key.Id)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
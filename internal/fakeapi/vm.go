@@ -0,0 +1,319 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_server"
+)
+
+const (
+	defaultVmSize      = "standard-2"
+	defaultVmUnixUser  = "ubi"
+	defaultVmBootImage = "ubuntu-noble"
+)
+
+type vm struct {
+	projectId   string
+	id          string
+	name        string
+	location    string
+	size        string
+	unixUser    string
+	storageSize int
+	subnetId    string
+	ip4         string
+	ip6         string
+	privateIpv4 string
+	privateIpv6 string
+	state       transition
+}
+
+// defaultStorageSize returns the storage size of a VM size in GiB, which is
+// 20 GiB per vCPU.
+func defaultStorageSize(size string) int {
+	var cpus int
+	if _, err := fmt.Sscanf(size[strings.LastIndex(size, "-")+1:], "%d", &cpus); err != nil {
+		return 40
+	}
+	return cpus * 20
+}
+
+func (s *Server) sortedVms() []*vm {
+	vms := make([]*vm, 0, len(s.vms))
+	for _, vm := range s.vms {
+		vms = append(vms, vm)
+	}
+	sortById(vms, func(vm *vm) string { return vm.id })
+	return vms
+}
+
+// findVm returns the VM named name. A VM being deleted is only returned if
+// there is no other VM with this name.
+func (s *Server) findVm(projectId string, location string, name string) *vm {
+	var found *vm
+	for _, vm := range s.sortedVms() {
+		if vm.projectId == projectId && vm.location == location && vm.name == name {
+			if vm.state.state != "deleting" {
+				return vm
+			}
+			found = vm
+		}
+	}
+	return found
+}
+
+// vm returns the VM named name or, if name is empty, with id id. It returns
+// nil if there is none, after writing a not found error if notFound is set.
+func (s *Server) vm(w http.ResponseWriter, projectId string, location string, name string, id string, notFound bool) (*vm, bool) {
+	if _, ok := s.projectLocation(w, projectId, location); !ok {
+		return nil, false
+	}
+
+	var found *vm
+	if name == "" {
+		if candidate, ok := s.vms[id]; ok && candidate.projectId == projectId && candidate.location == location {
+			found = candidate
+		}
+	} else {
+		found = s.findVm(projectId, location, name)
+	}
+
+	if found == nil && notFound {
+		writeNotFound(w)
+	}
+	return found, found != nil || !notFound
+}
+
+func (vm *vm) model() ubicloud_server.Vm {
+	return ubicloud_server.Vm{
+		Id:             ptr(vm.id),
+		Name:           ptr(vm.name),
+		State:          ptr(vm.state.state),
+		Location:       ptr(vm.location),
+		Size:           ptr(vm.size),
+		UnixUser:       ptr(vm.unixUser),
+		StorageSizeGib: ptr(vm.storageSize),
+		Ip4:            optional(vm.ip4),
+		Ip6:            ptr(vm.ip6),
+	}
+}
+
+func (s *Server) vmDetailedModel(vm *vm) ubicloud_server.VmDetailed {
+	subnetName := ""
+	if ps, ok := s.subnets[vm.subnetId]; ok {
+		subnetName = ps.name
+	}
+
+	return ubicloud_server.VmDetailed{
+		Id:             ptr(vm.id),
+		Name:           ptr(vm.name),
+		State:          ptr(vm.state.state),
+		Location:       ptr(vm.location),
+		Size:           ptr(vm.size),
+		UnixUser:       ptr(vm.unixUser),
+		StorageSizeGib: ptr(vm.storageSize),
+		Ip4:            optional(vm.ip4),
+		Ip6:            ptr(vm.ip6),
+		PrivateIpv4:    ptr(vm.privateIpv4),
+		PrivateIpv6:    ptr(vm.privateIpv6),
+		Subnet:         ptr(subnetName),
+		Firewalls:      ptr(s.subnetFirewalls(vm.subnetId)),
+	}
+}
+
+func (vm *vm) nic() ubicloud_server.Nic {
+	return ubicloud_server.Nic{
+		Id:          ptr("nc" + vm.id[2:]),
+		Name:        ptr(vm.name + "-nic"),
+		PrivateIpv4: ptr(vm.privateIpv4),
+		PrivateIpv6: ptr(vm.privateIpv6),
+		VmName:      ptr(vm.name),
+	}
+}
+
+func (s *Server) ListProjectVMs(w http.ResponseWriter, r *http.Request, projectId string, params ubicloud_server.ListProjectVMsParams) {
+	s.listVms(w, projectId, "", params.StartAfter, params.PageSize, params.OrderColumn)
+}
+
+func (s *Server) ListLocationVMs(w http.ResponseWriter, r *http.Request, projectId string, location string, params ubicloud_server.ListLocationVMsParams) {
+	s.listVms(w, projectId, location, params.StartAfter, params.PageSize, params.OrderColumn)
+}
+
+// listVms lists the VMs of a project, in location unless it is empty.
+func (s *Server) listVms(w http.ResponseWriter, projectId string, location string, startAfter *string, pageSize *int, orderColumn *string) {
+	if _, ok := s.project(w, projectId); !ok {
+		return
+	}
+
+	vms := []ubicloud_server.Vm{}
+	for _, vm := range s.vms {
+		if vm.projectId == projectId && (location == "" || vm.location == location) {
+			vms = append(vms, vm.model())
+		}
+	}
+
+	page, err := paginate(vms, func(vm ubicloud_server.Vm) string { return *vm.Id }, startAfter, pageSize, orderColumn)
+	writePage(w, page, err)
+}
+
+func (s *Server) CreateVM(w http.ResponseWriter, r *http.Request, projectId string, location string, vmName string) {
+	catalog, ok := s.projectLocation(w, projectId, location)
+	if !ok {
+		return
+	}
+
+	var body ubicloud_server.CreateVMJSONBody
+	if !readJSON(w, r, &body) || !validName(w, vmName) {
+		return
+	}
+	if existing := s.findVm(projectId, location, vmName); existing != nil && existing.state.state != "deleting" {
+		writeInvalidRequest(w, fmt.Sprintf("VM with name %q already exists", vmName))
+		return
+	}
+
+	if (valueOrEmpty(body.PublicKey) == "") == (valueOrEmpty(body.SshPublicKeyId) == "") {
+		writeInvalidRequest(w, "Exactly one of public_key and ssh_public_key_id is required")
+		return
+	}
+	if body.SshPublicKeyId != nil {
+		if key, ok := s.sshPublicKeys[*body.SshPublicKeyId]; !ok || key.projectId != projectId {
+			writeInvalidRequest(w, fmt.Sprintf("SSH public key with id %q does not exist", *body.SshPublicKeyId))
+			return
+		}
+	}
+
+	size := defaultVmSize
+	if body.Size != nil {
+		size = *body.Size
+	}
+	bootImage := defaultVmBootImage
+	if body.BootImage != nil {
+		bootImage = *body.BootImage
+	}
+	if !validOption(w, "size", size, catalog.VmSizes) || !validOption(w, "boot_image", bootImage, catalog.BootImages) {
+		return
+	}
+
+	storageSize := defaultStorageSize(size)
+	if body.StorageSize != nil {
+		storageSize = *body.StorageSize
+	}
+	if storageSize <= 0 {
+		writeInvalidRequest(w, fmt.Sprintf("Invalid storage_size %d", storageSize))
+		return
+	}
+
+	var ps *privateSubnet
+	if body.PrivateSubnetId != nil {
+		ps = s.subnets[*body.PrivateSubnetId]
+		if ps == nil || ps.projectId != projectId || ps.location != location {
+			writeInvalidRequest(w, fmt.Sprintf("Private subnet with id %q and location %q does not exist", *body.PrivateSubnetId, location))
+			return
+		}
+	} else {
+		ps = s.defaultPrivateSubnet(projectId, location)
+	}
+
+	id := s.newId("vm")
+	ps.hosts++
+	vm := &vm{
+		projectId:   projectId,
+		id:          id,
+		name:        vmName,
+		location:    location,
+		size:        size,
+		unixUser:    defaultVmUnixUser,
+		storageSize: storageSize,
+		subnetId:    ps.id,
+		ip6:         fmt.Sprintf("2001:db8:0:%x::2", s.nextId),
+		privateIpv4: addHost(ps.net4, ps.hosts+3),
+		privateIpv6: addHost(ps.net6, ps.hosts+1),
+		state:       s.newTransition("creating"),
+	}
+	if body.UnixUser != nil {
+		vm.unixUser = *body.UnixUser
+	}
+	if body.EnableIp4 != nil && *body.EnableIp4 {
+		vm.ip4 = fmt.Sprintf("192.0.2.%d", s.nextId%254+1)
+	}
+	s.vms[id] = vm
+	writeJSON(w, http.StatusOK, s.vmDetailedModel(vm))
+}
+
+// defaultPrivateSubnet returns the subnet of VMs created without
+// private_subnet_id, creating it on first use.
+func (s *Server) defaultPrivateSubnet(projectId string, location string) *privateSubnet {
+	name := "default-" + location
+	if ps := s.findPrivateSubnet(projectId, location, name); ps != nil {
+		return ps
+	}
+
+	ps := s.addPrivateSubnet(projectId, location, name)
+	fw := s.addDefaultFirewall(ps)
+	fw.subnetIds = append(fw.subnetIds, ps.id)
+	return ps
+}
+
+func (s *Server) GetVMDetails(w http.ResponseWriter, r *http.Request, projectId string, location string, vmName string) {
+	if vm, ok := s.vm(w, projectId, location, vmName, "", true); ok {
+		s.getVm(w, vm)
+	}
+}
+
+func (s *Server) GetVMDetailsWithId(w http.ResponseWriter, r *http.Request, projectId string, location string, vmId string) {
+	if vm, ok := s.vm(w, projectId, location, "", vmId, true); ok {
+		s.getVm(w, vm)
+	}
+}
+
+func (s *Server) getVm(w http.ResponseWriter, vm *vm) {
+	if !vm.state.read() {
+		delete(s.vms, vm.id)
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.vmDetailedModel(vm))
+}
+
+func (s *Server) DeleteVM(w http.ResponseWriter, r *http.Request, projectId string, location string, vmName string) {
+	if vm, ok := s.vm(w, projectId, location, vmName, "", false); ok {
+		s.deleteVm(w, vm)
+	}
+}
+
+func (s *Server) DeleteVMWithId(w http.ResponseWriter, r *http.Request, projectId string, location string, vmId string) {
+	if vm, ok := s.vm(w, projectId, location, "", vmId, false); ok {
+		s.deleteVm(w, vm)
+	}
+}
+
+func (s *Server) deleteVm(w http.ResponseWriter, vm *vm) {
+	if vm != nil && vm.state.state != "deleting" {
+		vm.state = s.newTransition("deleting")
+		if vm.state.remaining == 0 {
+			delete(s.vms, vm.id)
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// addHost returns the address with number host in prefix, without prefix
+// length.
+func addHost(prefix string, host int) string {
+	addr := netip.MustParsePrefix(prefix).Addr()
+	for range host {
+		addr = addr.Next()
+	}
+	return addr.String()
+}
+
+// optional returns nil for an empty value, which is omitted in responses.
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
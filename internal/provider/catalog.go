@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// catalog lists the locations of Ubicloud and the options available in each
+// of them. It is used to validate configurations before anything is created.
+type catalog struct {
+	locations []catalogLocation
+	// static is set when the catalog is the built-in fallback table, which
+	// may be out of date. Unknown values are then reported as warnings.
+	static bool
+}
+
+type catalogLocation struct {
+	name             string
+	vmSizes          []string
+	bootImages       []string
+	postgresSizes    []string
+	postgresHaTypes  []string
+	postgresVersions []string
+}
+
+var staticCatalogSizes = []string{"standard-2", "standard-4", "standard-8", "standard-16", "standard-30", "standard-60"}
+
+func newStaticCatalogLocation(name string) catalogLocation {
+	return catalogLocation{
+		name:             name,
+		vmSizes:          append([]string{"burstable-1", "burstable-2"}, staticCatalogSizes...),
+		bootImages:       []string{"ubuntu-noble", "ubuntu-jammy", "debian-12", "almalinux-9"},
+		postgresSizes:    staticCatalogSizes,
+		postgresHaTypes:  []string{"none", "async", "sync"},
+		postgresVersions: []string{"16", "17"},
+	}
+}
+
+// staticCatalog is used when the catalog cannot be fetched from the API.
+var staticCatalog = &catalog{
+	locations: []catalogLocation{
+		newStaticCatalogLocation("eu-central-h1"),
+		newStaticCatalogLocation("eu-north-h1"),
+		newStaticCatalogLocation("us-east-a2"),
+	},
+	static: true,
+}
+
+// catalogRetryInterval is how long the static catalog is used after the
+// catalog could not be fetched, before it is fetched again.
+const catalogRetryInterval = time.Minute
+
+// catalogCache holds the catalog fetched from the API. It is a pointer in
+// UbicloudClient, so that it is shared between the copies handed to
+// resources.
+type catalogCache struct {
+	// client fetches the catalog without retrying failed requests, as the
+	// static catalog is good enough to validate configurations.
+	client *ubicloud.Client
+
+	mu       sync.Mutex
+	catalog  *catalog
+	failedAt time.Time
+}
+
+// getCatalog returns the catalog of the API, fetching it on first use. The
+// static catalog is returned when the client is not configured or the
+// catalog cannot be fetched. A failure is remembered for
+// catalogRetryInterval, so that the configuration of every resource doesn't
+// fetch it again, without sticking for the whole run.
+func (uc *UbicloudClient) getCatalog(ctx context.Context) *catalog {
+	if uc == nil || uc.catalog == nil {
+		return staticCatalog
+	}
+
+	uc.catalog.mu.Lock()
+	defer uc.catalog.mu.Unlock()
+	if uc.catalog.catalog != nil {
+		return uc.catalog.catalog
+	}
+	if !uc.catalog.failedAt.IsZero() && time.Since(uc.catalog.failedAt) < catalogRetryInterval {
+		return staticCatalog
+	}
+
+	c := fetchCatalog(ctx, uc.catalog.client)
+	if c.static {
+		uc.catalog.failedAt = time.Now()
+	} else {
+		uc.catalog.catalog = c
+	}
+	return c
+}
+
+func fetchCatalog(ctx context.Context, client *ubicloud.Client) *catalog {
+	tflog.Debug(ctx, "Fetching location catalog")
+	locationsResp, err := client.ListLocationsWithResponse(ctx)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error fetching location catalog, using built-in catalog: %s", err.Error()))
+		return staticCatalog
+	}
+
+	if locationsResp.StatusCode() != http.StatusOK || locationsResp.JSON200 == nil || locationsResp.JSON200.Items == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Received %s fetching location catalog, using built-in catalog", locationsResp.Status()))
+		return staticCatalog
+	}
+
+	c := &catalog{}
+	for _, l := range *locationsResp.JSON200.Items {
+		if l.Name == nil {
+			continue
+		}
+		c.locations = append(c.locations, catalogLocation{
+			name:             *l.Name,
+			vmSizes:          valueOrEmpty(l.VmSizes),
+			bootImages:       valueOrEmpty(l.BootImages),
+			postgresSizes:    valueOrEmpty(l.PostgresSizes),
+			postgresHaTypes:  valueOrEmpty(l.PostgresHaTypes),
+			postgresVersions: valueOrEmpty(l.PostgresVersions),
+		})
+	}
+
+	if len(c.locations) == 0 {
+		tflog.Warn(ctx, "Received an empty location catalog, using built-in catalog")
+		return staticCatalog
+	}
+	return c
+}
+
+func valueOrEmpty(values *[]string) []string {
+	if values == nil {
+		return nil
+	}
+	return *values
+}
+
+// locationValidator returns a validator accepting the names of the locations
+// in the catalog.
+func (c *catalog) locationValidator() catalogValidator {
+	names := make([]string, 0, len(c.locations))
+	for _, l := range c.locations {
+		names = append(names, l.name)
+	}
+	return catalogValidator{kind: "location", values: names, static: c.static}
+}
+
+// optionValidator returns a validator accepting the values of an option in the
+// given location. When the location is unknown, values available in any
+// location are accepted.
+func (c *catalog) optionValidator(kind string, location string, option func(l *catalogLocation) []string) catalogValidator {
+	var values []string
+	for i := range c.locations {
+		if c.locations[i].name == location {
+			return catalogValidator{kind: kind, values: option(&c.locations[i]), static: c.static}
+		}
+		for _, value := range option(&c.locations[i]) {
+			if !slices.Contains(values, value) {
+				values = append(values, value)
+			}
+		}
+	}
+	return catalogValidator{kind: kind, values: values, static: c.static}
+}
+
+// validateCatalogAttribute validates a string attribute of a resource
+// configuration against the catalog.
+func validateCatalogAttribute(ctx context.Context, config tfsdk.Config, name string, v catalogValidator, diags *diag.Diagnostics) {
+	var value types.String
+	getDiags := config.GetAttribute(ctx, path.Root(name), &value)
+	diags.Append(getDiags...)
+	if getDiags.HasError() {
+		return
+	}
+
+	req := validator.StringRequest{
+		Path:           path.Root(name),
+		PathExpression: path.MatchRoot(name),
+		ConfigValue:    value,
+		Config:         config,
+	}
+	resp := &validator.StringResponse{}
+	v.ValidateString(ctx, req, resp)
+	diags.Append(resp.Diagnostics...)
+}
+
+// configLocation returns the location of a resource configuration, or an
+// empty string when it is not known yet.
+func configLocation(ctx context.Context, config tfsdk.Config) string {
+	var location types.String
+	if diags := config.GetAttribute(ctx, path.Root("location"), &location); diags.HasError() {
+		return ""
+	}
+	return location.ValueString()
+}
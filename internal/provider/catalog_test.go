@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func newCatalogTestClient(t *testing.T, status int, body string) *UbicloudClient {
+	return newCatalogTestClientFunc(t, func() (int, string) { return status, body })
+}
+
+// newCatalogTestClientFunc returns a client of an API answering the requests
+// for the catalog with the status and body returned by respond.
+func newCatalogTestClientFunc(t *testing.T, respond func() (int, string)) *UbicloudClient {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/location" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		status, body := respond()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := ubicloud.NewClient("test", ubicloud.WithEndpoint(server.URL), ubicloud.WithRetryPolicy(ubicloud.RetryPolicy{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &UbicloudClient{endpoint: server.URL, client: client, catalog: &catalogCache{client: client}}
+}
+
+func TestGetCatalog(t *testing.T) {
+	uc := newCatalogTestClient(t, http.StatusOK, `{"items": [
+		{"name": "eu-central-h1", "vm_sizes": ["standard-2", "standard-4"], "postgres_versions": ["16"]},
+		{"name": "us-east-a2", "vm_sizes": ["standard-2", "standard-60"], "postgres_versions": ["16", "17"]}
+	], "count": 2}`)
+
+	c := uc.getCatalog(context.Background())
+	if c.static {
+		t.Fatalf("expected the catalog fetched from the API")
+	}
+	if c != uc.getCatalog(context.Background()) {
+		t.Errorf("expected the catalog to be fetched once")
+	}
+
+	if got := c.locationValidator().values; !slices.Equal(got, []string{"eu-central-h1", "us-east-a2"}) {
+		t.Errorf("unexpected locations: %v", got)
+	}
+
+	vmSizes := func(l *catalogLocation) []string { return l.vmSizes }
+	if got := c.optionValidator("VM size", "eu-central-h1", vmSizes).values; !slices.Equal(got, []string{"standard-2", "standard-4"}) {
+		t.Errorf("unexpected VM sizes in location: %v", got)
+	}
+	if got := c.optionValidator("VM size", "", vmSizes).values; !slices.Equal(got, []string{"standard-2", "standard-4", "standard-60"}) {
+		t.Errorf("unexpected VM sizes in all locations: %v", got)
+	}
+}
+
+func TestGetCatalogFallback(t *testing.T) {
+	tests := map[string]*UbicloudClient{
+		"not configured": nil,
+		"not found":      newCatalogTestClient(t, http.StatusNotFound, `{}`),
+		"empty":          newCatalogTestClient(t, http.StatusOK, `{"items": [], "count": 0}`),
+	}
+
+	for name, uc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if c := uc.getCatalog(context.Background()); c != staticCatalog {
+				t.Errorf("expected the static catalog, got %v", c)
+			}
+		})
+	}
+}
+
+func TestGetCatalogAfterFailure(t *testing.T) {
+	var requests int
+	uc := newCatalogTestClientFunc(t, func() (int, string) {
+		requests++
+		if requests == 1 {
+			return http.StatusServiceUnavailable, `{}`
+		}
+		return http.StatusOK, `{"items": [{"name": "eu-central-h1", "vm_sizes": ["standard-2"]}], "count": 1}`
+	})
+
+	if c := uc.getCatalog(context.Background()); c != staticCatalog {
+		t.Fatalf("expected the static catalog while the API fails, got %v", c)
+	}
+	if c := uc.getCatalog(context.Background()); c != staticCatalog || requests != 1 {
+		t.Fatalf("expected the failure to be remembered, got %d requests", requests)
+	}
+
+	uc.catalog.failedAt = time.Now().Add(-catalogRetryInterval)
+	c := uc.getCatalog(context.Background())
+	if c.static {
+		t.Fatalf("expected the catalog to be fetched again after %s", catalogRetryInterval)
+	}
+	if c != uc.getCatalog(context.Background()) || requests != 2 {
+		t.Errorf("expected the catalog to be kept once fetched, got %d requests", requests)
+	}
+}
+
+func TestValidateConfigNotConfigured(t *testing.T) {
+	tests := map[string]struct {
+		resource resource.Resource
+		config   map[string]string
+	}{
+		"vm": {
+			resource: NewVmResource(),
+			config:   map[string]string{"location": "eu-central-h1", "size": "standard-3", "public_key": "ssh-ed25519 AAAA"},
+		},
+		"postgres": {
+			resource: NewPostgresResource(),
+			config:   map[string]string{"location": "eu-central-h1", "size": "standard-3"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			schemaResp := &resource.SchemaResponse{}
+			test.resource.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			attributes := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				if value, ok := test.config[name]; ok {
+					attributes[name] = tftypes.NewValue(attributeType, value)
+				} else {
+					attributes[name] = tftypes.NewValue(attributeType, nil)
+				}
+			}
+			config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+
+			resp := &resource.ValidateConfigResponse{}
+			test.resource.(resource.ResourceWithValidateConfig).ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, resp)
+
+			if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+				t.Fatalf("expected a warning for the size missing from the static catalog, got %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.Warnings()[0].Summary(); !strings.Contains(got, "size") {
+				t.Errorf("unexpected warning: %s", got)
+			}
+		})
+	}
+}
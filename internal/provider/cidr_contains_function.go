@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &cidrContainsFunction{}
+
+func NewCidrContainsFunction() function.Function {
+	return &cidrContainsFunction{}
+}
+
+type cidrContainsFunction struct{}
+
+func (f *cidrContainsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_contains"
+}
+
+func (f *cidrContainsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Checks whether a CIDR contains an IP address or another CIDR",
+		MarkdownDescription: "Returns true if `containing_cidr` contains `address_or_cidr`, which is either an IP address or a CIDR. " +
+			"A CIDR is contained if all of its addresses are. IPv4 and IPv6 are supported, an IPv4 value is never contained in an IPv6 CIDR and vice versa.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "containing_cidr",
+				MarkdownDescription: "CIDR to check, e.g. the `net4` or `net6` of a private subnet.",
+			},
+			function.StringParameter{
+				Name:                "address_or_cidr",
+				MarkdownDescription: "IP address or CIDR which may be contained in `containing_cidr`.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *cidrContainsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var containingCidr, addressOrCidr string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &containingCidr, &addressOrCidr))
+	if resp.Error != nil {
+		return
+	}
+
+	containing, funcErr := parsePrefixArgument(0, containingCidr)
+	resp.Error = function.ConcatFuncErrors(resp.Error, funcErr)
+	contained, funcErr := parseAddressOrPrefixArgument(1, addressOrCidr)
+	resp.Error = function.ConcatFuncErrors(resp.Error, funcErr)
+	if resp.Error != nil {
+		return
+	}
+
+	result := containing.Bits() <= contained.Bits() && containing.Contains(contained.Addr())
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
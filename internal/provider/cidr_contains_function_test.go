@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCidrContainsFunction(t *testing.T) {
+	args := func(a, b string) []attr.Value {
+		return []attr.Value{types.StringValue(a), types.StringValue(b)}
+	}
+
+	runFunctionTests(t, NewCidrContainsFunction(), map[string]functionTestCase{
+		"ipv4 address":            {arguments: args("10.0.0.0/8", "10.1.2.3"), expected: types.BoolValue(true)},
+		"ipv4 address outside":    {arguments: args("10.0.0.0/8", "11.0.0.1"), expected: types.BoolValue(false)},
+		"ipv4 cidr":               {arguments: args("10.0.0.0/8", "10.2.0.0/16"), expected: types.BoolValue(true)},
+		"ipv4 same cidr":          {arguments: args("10.0.0.0/8", "10.0.0.0/8"), expected: types.BoolValue(true)},
+		"ipv4 larger cidr":        {arguments: args("10.2.0.0/16", "10.0.0.0/8"), expected: types.BoolValue(false)},
+		"host bits":               {arguments: args("10.1.2.3/8", "10.200.0.0/16"), expected: types.BoolValue(true)},
+		"ipv6 address":            {arguments: args("fd10:9b0b:6b4b:8fbb::/64", "fd10:9b0b:6b4b:8fbb:abc::2"), expected: types.BoolValue(true)},
+		"ipv6 address outside":    {arguments: args("fd10:9b0b:6b4b:8fbb::/64", "fd10:9b0b:6b4b:8fbc::2"), expected: types.BoolValue(false)},
+		"ipv6 cidr":               {arguments: args("2a01:4f8:10a:128b::/64", "2a01:4f8:10a:128b:4919::/80"), expected: types.BoolValue(true)},
+		"ipv6 cidr across 64 bit": {arguments: args("2a01:4f8::/32", "2a01:4f8:10a:128b::/64"), expected: types.BoolValue(true)},
+		"mixed versions":          {arguments: args("::/0", "10.0.0.1"), expected: types.BoolValue(false)},
+		"invalid cidr":            {arguments: args("10.0.0.0", "10.0.0.1"), error: true},
+		"invalid address":         {arguments: args("10.0.0.0/8", "10.0.0"), error: true},
+	})
+}
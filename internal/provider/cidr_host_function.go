@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &cidrHostFunction{}
+
+func NewCidrHostFunction() function.Function {
+	return &cidrHostFunction{}
+}
+
+type cidrHostFunction struct{}
+
+func (f *cidrHostFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_host"
+}
+
+func (f *cidrHostFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Calculates the IP address of a host number within a CIDR",
+		MarkdownDescription: "Returns the IP address with number `hostnum` in `cidr`, without prefix length. " +
+			"Negative numbers count back from the last address, so -1 is the last address of the CIDR. " +
+			"Unlike the built-in `cidrhost`, the calculation works on the whole address, so it is exact for IPv6 CIDRs of any size.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "CIDR of the network, e.g. the `net4` or `net6` of a private subnet.",
+			},
+			function.Int64Parameter{
+				Name:                "hostnum",
+				MarkdownDescription: "Number of the host in the network, starting at 0 for the network address.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *cidrHostFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var hostnum int64
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &cidr, &hostnum))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, funcErr := parsePrefixArgument(0, cidr)
+	resp.Error = function.ConcatFuncErrors(resp.Error, funcErr)
+	if resp.Error != nil {
+		return
+	}
+
+	addr, err := prefixHost(prefix, hostnum)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, addr.String()))
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCidrHostFunction(t *testing.T) {
+	args := func(cidr string, hostnum int64) []attr.Value {
+		return []attr.Value{types.StringValue(cidr), types.Int64Value(hostnum)}
+	}
+
+	runFunctionTests(t, NewCidrHostFunction(), map[string]functionTestCase{
+		"ipv4 network":        {arguments: args("10.0.0.0/24", 0), expected: types.StringValue("10.0.0.0")},
+		"ipv4 host":           {arguments: args("10.0.0.0/24", 5), expected: types.StringValue("10.0.0.5")},
+		"ipv4 carry":          {arguments: args("10.0.0.0/16", 300), expected: types.StringValue("10.0.1.44")},
+		"ipv4 last":           {arguments: args("10.0.0.0/24", -1), expected: types.StringValue("10.0.0.255")},
+		"ipv4 host bits":      {arguments: args("10.0.0.17/24", 1), expected: types.StringValue("10.0.0.1")},
+		"ipv4 single":         {arguments: args("10.0.0.7/32", 0), expected: types.StringValue("10.0.0.7")},
+		"ipv6 host":           {arguments: args("fd10:9b0b:6b4b:8fbb::/64", 2), expected: types.StringValue("fd10:9b0b:6b4b:8fbb::2")},
+		"ipv6 last":           {arguments: args("fd10:9b0b:6b4b:8fbb::/64", -1), expected: types.StringValue("fd10:9b0b:6b4b:8fbb:ffff:ffff:ffff:ffff")},
+		"ipv6 whole space":    {arguments: args("::/0", -1), expected: types.StringValue("ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff")},
+		"ipv6 high number":    {arguments: args("fd10::ffff:ffff:ffff:ffff/63", 1<<62), expected: types.StringValue("fd10::4000:0:0:0")},
+		"ipv4 out of range":   {arguments: args("10.0.0.0/24", 256), error: true},
+		"ipv4 negative range": {arguments: args("10.0.0.0/24", -257), error: true},
+		"ipv6 out of range":   {arguments: args("fd10::/120", 256), error: true},
+		"invalid cidr":        {arguments: args("10.0.0.0", 1), error: true},
+	})
+}
+
+func TestPrefixHostLargeIPv6(t *testing.T) {
+	// The host number is added across the 64 bit boundary of the address.
+	prefix := netip.MustParsePrefix("2a01:4f8:10a:128b::/56")
+	addr, err := prefixHost(prefix, -1<<32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := addr.String(), "2a01:4f8:10a:12ff:ffff:ffff::"; got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &cidrOverlapsFunction{}
+
+func NewCidrOverlapsFunction() function.Function {
+	return &cidrOverlapsFunction{}
+}
+
+type cidrOverlapsFunction struct{}
+
+func (f *cidrOverlapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+func (f *cidrOverlapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Checks whether two CIDRs have addresses in common",
+		MarkdownDescription: "Returns true if `cidr_a` and `cidr_b` have at least one address in common, which is the case when one contains the other. " +
+			"CIDRs of different IP versions never overlap.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_a",
+				MarkdownDescription: "First CIDR.",
+			},
+			function.StringParameter{
+				Name:                "cidr_b",
+				MarkdownDescription: "Second CIDR.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *cidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrA, cidrB string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &cidrA, &cidrB))
+	if resp.Error != nil {
+		return
+	}
+
+	prefixA, funcErr := parsePrefixArgument(0, cidrA)
+	resp.Error = function.ConcatFuncErrors(resp.Error, funcErr)
+	prefixB, funcErr := parsePrefixArgument(1, cidrB)
+	resp.Error = function.ConcatFuncErrors(resp.Error, funcErr)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, prefixA.Overlaps(prefixB)))
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCidrOverlapsFunction(t *testing.T) {
+	args := func(a, b string) []attr.Value {
+		return []attr.Value{types.StringValue(a), types.StringValue(b)}
+	}
+
+	runFunctionTests(t, NewCidrOverlapsFunction(), map[string]functionTestCase{
+		"ipv4 contained":   {arguments: args("10.0.0.0/8", "10.2.0.0/16"), expected: types.BoolValue(true)},
+		"ipv4 containing":  {arguments: args("10.2.0.0/16", "10.0.0.0/8"), expected: types.BoolValue(true)},
+		"ipv4 disjoint":    {arguments: args("10.0.0.0/16", "10.1.0.0/16"), expected: types.BoolValue(false)},
+		"ipv6 contained":   {arguments: args("2a01:4f8::/32", "2a01:4f8:10a:128b::/64"), expected: types.BoolValue(true)},
+		"ipv6 disjoint":    {arguments: args("fd10:9b0b:6b4b:8fbb::/64", "fd10:9b0b:6b4b:8fbc::/64"), expected: types.BoolValue(false)},
+		"ipv6 host bits":   {arguments: args("fd10:9b0b:6b4b:8fbb::1/64", "fd10:9b0b:6b4b:8fbb:1::/80"), expected: types.BoolValue(true)},
+		"mixed versions":   {arguments: args("0.0.0.0/0", "::/0"), expected: types.BoolValue(false)},
+		"invalid first":    {arguments: args("10.0.0.0/33", "10.0.0.0/8"), error: true},
+		"invalid second":   {arguments: args("10.0.0.0/8", "fd10::/129"), error: true},
+		"address not cidr": {arguments: args("10.0.0.0/8", "10.0.0.1"), error: true},
+	})
+}
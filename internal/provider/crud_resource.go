@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	// waitInterval is the interval between the reads of resources waited
+	// for. Tests against the fake API shorten it.
+	waitInterval = 5 * time.Second
+	// defaultWaitTimeout bounds the waits for resources when the timeouts
+	// block doesn't set a timeout.
+	defaultWaitTimeout = 30 * time.Minute
+)
+
+var (
+	_ resource.Resource                = &crudResource[struct{}, struct{}]{}
+	_ resource.ResourceWithConfigure   = &crudResource[struct{}, struct{}]{}
+	_ resource.ResourceWithImportState = &crudResource[struct{}, struct{}]{}
+)
+
+// diagnosticsError returns diagnostics from the hooks of a crudResource,
+// which adds them as they are.
+type diagnosticsError struct {
+	diags diag.Diagnostics
+}
+
+func (e diagnosticsError) Error() string {
+	var messages []string
+	for _, d := range e.diags.Errors() {
+		messages = append(messages, d.Summary()+": "+d.Detail())
+	}
+	return strings.Join(messages, "\n")
+}
+
+// crudResource implements a resource from hooks calling the API, where Model
+// is the Terraform model of the resource and APIType the object the API
+// returns for it. Configuration, diagnostics, import and objects deleted
+// outside of Terraform are handled here, once for all resources. Resources
+// needing more embed it and add or override methods.
+type crudResource[Model any, APIType any] struct {
+	uc *UbicloudClient
+
+	// typeName is the name of the resource type, without the provider prefix.
+	typeName string
+	// noun names the resource in logs and diagnostics.
+	noun   string
+	schema func(ctx context.Context) schema.Schema
+	// importAttributes are the attributes the import identifier is made of,
+	// separated by commas.
+	importAttributes []string
+	// identifier returns the attributes identifying the resource in logs and
+	// diagnostics.
+	identifier func(state *Model) string
+
+	create func(ctx context.Context, uc *UbicloudClient, plan *Model) (*APIType, error)
+	// afterCreate, if set, is called with the plan once the created resource
+	// is saved in state, for changes the create call of the API can't make,
+	// so that a failure does not leave the resource untracked. It returns nil
+	// if there was nothing to change.
+	afterCreate func(ctx context.Context, uc *UbicloudClient, plan *Model) (*APIType, error)
+	// read returns an error matching ubicloud.ErrNotFound if the resource
+	// doesn't exist anymore.
+	read func(ctx context.Context, uc *UbicloudClient, state *Model) (*APIType, error)
+	// update is nil for resources that can't be updated, and replaced
+	// instead. It returns nil if there was nothing to send to the API, in
+	// which case the state is kept as is.
+	update func(ctx context.Context, uc *UbicloudClient, plan *Model, state *Model) (*APIType, error)
+	// delete succeeds if the resource doesn't exist anymore.
+	delete   func(ctx context.Context, uc *UbicloudClient, state *Model) error
+	setState func(ctx context.Context, object *APIType, state *Model) diag.Diagnostics
+
+	// waitReady, if set, is called once the created resource is saved in
+	// state, and returns it once it can be used, e.g. when a VM is running.
+	// It is bounded by the create timeout of the timeouts block, which the
+	// schema must then have.
+	waitReady func(ctx context.Context, uc *UbicloudClient, state *Model) (*APIType, error)
+	// waitDeleted, if set, is called after the delete and returns once the
+	// resource doesn't exist anymore. It is bounded by the delete timeout of
+	// the timeouts block, which the schema must then have.
+	waitDeleted func(ctx context.Context, uc *UbicloudClient, state *Model) error
+}
+
+func (r *crudResource[Model, APIType]) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	uc, ok := req.ProviderData.(UbicloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected UbicloudClient, got: %T. Please report this issue to support@ubicloud.com.", req.ProviderData),
+		)
+		return
+	}
+
+	r.uc = &uc
+}
+
+func (r *crudResource[Model, APIType]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+}
+
+func (r *crudResource[Model, APIType]) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.schema(ctx)
+}
+
+func (r *crudResource[Model, APIType]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state Model
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan := state
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating %s: %s", r.noun, r.identifier(&state)))
+	object, err := r.create(ctx, r.uc, &state)
+	if err != nil {
+		r.addError(&resp.Diagnostics, "creating", &state, err)
+		return
+	}
+
+	resp.Diagnostics.Append(r.setState(ctx, object, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.waitReady != nil {
+		var t timeouts.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+		waitCtx, cancel := timeoutContext(ctx, t, timeouts.Value.Create, &resp.Diagnostics)
+		defer cancel()
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for %s to be ready: %s", r.noun, r.identifier(&state)))
+		object, err = r.waitReady(waitCtx, r.uc, &state)
+		if err != nil {
+			r.addError(&resp.Diagnostics, "waiting for", &state, err)
+			return
+		}
+
+		resp.Diagnostics.Append(r.setState(ctx, object, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if r.afterCreate == nil {
+		return
+	}
+
+	object, err = r.afterCreate(ctx, r.uc, &plan)
+	if err != nil {
+		r.addError(&resp.Diagnostics, "creating", &plan, err)
+		return
+	}
+	if object == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setState(ctx, object, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *crudResource[Model, APIType]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Model
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading %s: %s", r.noun, r.identifier(&state)))
+	object, err := r.read(ctx, r.uc, &state)
+	if errors.Is(err, ubicloud.ErrNotFound) {
+		tflog.Debug(ctx, fmt.Sprintf("The %s was not found, removing it from state: %s", r.noun, r.identifier(&state)))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		r.addError(&resp.Diagnostics, "reading", &state, err)
+		return
+	}
+
+	resp.Diagnostics.Append(r.setState(ctx, object, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *crudResource[Model, APIType]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Model
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.update == nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Update of %s is not supported", r.noun),
+			fmt.Sprintf("Cannot update %s: %s", r.noun, r.identifier(&state)))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating %s: %s", r.noun, r.identifier(&state)))
+	object, err := r.update(ctx, r.uc, &plan, &state)
+	if err != nil {
+		r.addError(&resp.Diagnostics, "updating", &state, err)
+		return
+	}
+
+	if object == nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	resp.Diagnostics.Append(r.setState(ctx, object, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *crudResource[Model, APIType]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Model
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting %s: %s", r.noun, r.identifier(&state)))
+	if err := r.delete(ctx, r.uc, &state); err != nil {
+		if !errors.Is(err, ubicloud.ErrNotFound) {
+			r.addError(&resp.Diagnostics, "deleting", &state, err)
+		}
+		return
+	}
+
+	if r.waitDeleted == nil {
+		return
+	}
+
+	var t timeouts.Value
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	waitCtx, cancel := timeoutContext(ctx, t, timeouts.Value.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for %s to be deleted: %s", r.noun, r.identifier(&state)))
+	if err := r.waitDeleted(waitCtx, r.uc, &state); err != nil {
+		r.addError(&resp.Diagnostics, "waiting for the deletion of", &state, err)
+	}
+}
+
+func (r *crudResource[Model, APIType]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != len(r.importAttributes) || slices.Contains(idParts, "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s. Got: %q", strings.Join(r.importAttributes, ","), req.ID),
+		)
+		return
+	}
+
+	for i, name := range r.importAttributes {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), idParts[i])...)
+	}
+}
+
+// timeoutContext returns ctx bounded by the timeout the timeouts block sets
+// for an operation, defaultWaitTimeout if it sets none. timeout is the method
+// of timeouts.Value returning the timeout of the operation.
+func timeoutContext(ctx context.Context, t timeouts.Value, timeout func(timeouts.Value, context.Context, time.Duration) (time.Duration, diag.Diagnostics), diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	d, timeoutDiags := timeout(t, ctx, defaultWaitTimeout)
+	diags.Append(timeoutDiags...)
+	return context.WithTimeout(ctx, d)
+}
+
+// waitNotFound returns a waitDeleted hook calling read every waitInterval
+// until the resource is not found.
+func waitNotFound[Model any, APIType any](read func(ctx context.Context, uc *UbicloudClient, state *Model) (*APIType, error)) func(ctx context.Context, uc *UbicloudClient, state *Model) error {
+	return func(ctx context.Context, uc *UbicloudClient, state *Model) error {
+		return ubicloud.WaitForDeletion(ctx, waitInterval, func(ctx context.Context) error {
+			_, err := read(ctx, uc, state)
+			return err
+		})
+	}
+}
+
+// addError adds the diagnostic of an error of the API call made while action
+// is done on the resource.
+func (r *crudResource[Model, APIType]) addError(diags *diag.Diagnostics, action string, state *Model, err error) {
+	var diagsErr diagnosticsError
+	if errors.As(err, &diagsErr) {
+		diags.Append(diagsErr.diags...)
+		return
+	}
+
+	var apiErr *ubicloud.Error
+	if errors.As(err, &apiErr) {
+		detail := fmt.Sprintf("Received %s for %s: %s. Details: %s", apiErr.Status, r.noun, r.identifier(state), apiErr.Body)
+		if err != error(apiErr) {
+			// The hook made several calls, the error tells which one failed.
+			detail = fmt.Sprintf("For %s: %s: %s", r.noun, r.identifier(state), err)
+		}
+		diags.AddError(fmt.Sprintf("Unexpected HTTP status code %s %s", action, r.noun), detail)
+		return
+	}
+
+	diags.AddError(
+		fmt.Sprintf("Error %s %s: %s", action, r.noun, r.identifier(state)),
+		err.Error(),
+	)
+}
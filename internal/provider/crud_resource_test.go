@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type crudTestModel struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	ProjectId types.String `tfsdk:"project_id"`
+}
+
+type crudTestObject struct {
+	id string
+}
+
+var crudTestSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id":         schema.StringAttribute{Computed: true},
+		"name":       schema.StringAttribute{Required: true},
+		"project_id": schema.StringAttribute{Required: true},
+	},
+}
+
+// newCrudTestResource returns a resource whose API calls fail with err, and
+// otherwise return an object with the ID "id".
+func newCrudTestResource(err error) *crudResource[crudTestModel, crudTestObject] {
+	call := func(context.Context, *UbicloudClient, *crudTestModel) (*crudTestObject, error) {
+		if err != nil {
+			return nil, err
+		}
+		return &crudTestObject{id: "id"}, nil
+	}
+	return &crudResource[crudTestModel, crudTestObject]{
+		typeName:         "test",
+		noun:             "test object",
+		schema:           func(context.Context) schema.Schema { return crudTestSchema },
+		importAttributes: []string{"project_id", "name"},
+		identifier: func(state *crudTestModel) string {
+			return fmt.Sprintf("name=%s", state.Name.ValueString())
+		},
+		create: call,
+		read:   call,
+		delete: func(context.Context, *UbicloudClient, *crudTestModel) error { return err },
+		setState: func(_ context.Context, object *crudTestObject, state *crudTestModel) diag.Diagnostics {
+			state.Id = types.StringValue(object.id)
+			return nil
+		},
+	}
+}
+
+func newCrudTestState(id string) tfsdk.State {
+	return tfsdk.State{
+		Schema: crudTestSchema,
+		Raw: tftypes.NewValue(crudTestSchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+			"id":         tftypes.NewValue(tftypes.String, id),
+			"name":       tftypes.NewValue(tftypes.String, "test"),
+			"project_id": tftypes.NewValue(tftypes.String, "pj1"),
+		}),
+	}
+}
+
+func TestCrudResourceRead(t *testing.T) {
+	tests := map[string]struct {
+		err     error
+		removed bool
+		summary string
+	}{
+		"found": {},
+		"not found": {
+			err:     &ubicloud.Error{Status: "404 Not Found", StatusCode: 404},
+			removed: true,
+		},
+		"not found by the hook": {
+			err:     fmt.Errorf("not listed: %w", ubicloud.ErrNotFound),
+			removed: true,
+		},
+		"unexpected status": {
+			err:     &ubicloud.Error{Status: "500 Internal Server Error", StatusCode: 500, Body: []byte("oops")},
+			summary: "Unexpected HTTP status code reading test object",
+		},
+		"unexpected status of one of several calls": {
+			err:     fmt.Errorf("listing: %w", &ubicloud.Error{Status: "500 Internal Server Error", StatusCode: 500}),
+			summary: "Unexpected HTTP status code reading test object",
+		},
+		"error": {
+			err:     errors.New("connection refused"),
+			summary: "Error reading test object: name=test",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := newCrudTestResource(test.err)
+			resp := &resource.ReadResponse{State: newCrudTestState("")}
+			r.Read(ctx, resource.ReadRequest{State: newCrudTestState("")}, resp)
+
+			if test.summary != "" {
+				if errs := resp.Diagnostics.Errors(); len(errs) != 1 || errs[0].Summary() != test.summary {
+					t.Fatalf("expected error %q, got %v", test.summary, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			if got := resp.State.Raw.IsNull(); got != test.removed {
+				t.Fatalf("expected removed=%t, got %t", test.removed, got)
+			}
+			if test.removed {
+				return
+			}
+			var state crudTestModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if state.Id.ValueString() != "id" {
+				t.Errorf("expected the state to be set from the API object, got %v", state)
+			}
+		})
+	}
+}
+
+func TestCrudResourceDelete(t *testing.T) {
+	tests := map[string]struct {
+		err    error
+		errors int
+	}{
+		"deleted":   {},
+		"not found": {err: &ubicloud.Error{Status: "404 Not Found", StatusCode: 404}},
+		"unexpected status": {
+			err:    &ubicloud.Error{Status: "409 Conflict", StatusCode: 409},
+			errors: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := newCrudTestResource(test.err)
+			resp := &resource.DeleteResponse{State: newCrudTestState("id")}
+			r.Delete(context.Background(), resource.DeleteRequest{State: newCrudTestState("id")}, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != test.errors {
+				t.Errorf("expected %d errors, got %v", test.errors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+type crudWaitTestModel struct {
+	Id        types.String   `tfsdk:"id"`
+	Name      types.String   `tfsdk:"name"`
+	ProjectId types.String   `tfsdk:"project_id"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func crudWaitTestSchema() schema.Schema {
+	s := schema.Schema{Attributes: crudTestSchema.Attributes}
+	addTimeoutsBlock(context.Background(), &s, timeouts.Opts{Create: true, Delete: true})
+	return s
+}
+
+// newCrudWaitTestResource returns a resource waited for with waitReady and
+// waitDeleted, whose other API calls return an object with the ID "id".
+func newCrudWaitTestResource(deleteErr error, waitReady func(ctx context.Context) (*crudTestObject, error), waitDeleted func(ctx context.Context) error) *crudResource[crudWaitTestModel, crudTestObject] {
+	call := func(context.Context, *UbicloudClient, *crudWaitTestModel) (*crudTestObject, error) {
+		return &crudTestObject{id: "id"}, nil
+	}
+	return &crudResource[crudWaitTestModel, crudTestObject]{
+		typeName: "test",
+		noun:     "test object",
+		schema:   func(context.Context) schema.Schema { return crudWaitTestSchema() },
+		identifier: func(state *crudWaitTestModel) string {
+			return fmt.Sprintf("name=%s", state.Name.ValueString())
+		},
+		create: call,
+		read:   call,
+		delete: func(context.Context, *UbicloudClient, *crudWaitTestModel) error { return deleteErr },
+		setState: func(_ context.Context, object *crudTestObject, state *crudWaitTestModel) diag.Diagnostics {
+			state.Id = types.StringValue(object.id)
+			return nil
+		},
+		waitReady: func(ctx context.Context, _ *UbicloudClient, _ *crudWaitTestModel) (*crudTestObject, error) {
+			return waitReady(ctx)
+		},
+		waitDeleted: func(ctx context.Context, _ *UbicloudClient, _ *crudWaitTestModel) error {
+			return waitDeleted(ctx)
+		},
+	}
+}
+
+// newCrudWaitTestValue returns the value of a resource with the timeouts
+// block setting the timeouts, or null if there are none.
+func newCrudWaitTestValue(id tftypes.Value, timeoutValues map[string]string) tftypes.Value {
+	objectType := crudWaitTestSchema().Type().TerraformType(context.Background()).(tftypes.Object)
+	timeoutsType := objectType.AttributeTypes["timeouts"]
+	timeoutsValue := tftypes.NewValue(timeoutsType, nil)
+	if timeoutValues != nil {
+		attrs := map[string]tftypes.Value{}
+		for name := range timeoutsType.(tftypes.Object).AttributeTypes {
+			attrs[name] = tftypes.NewValue(tftypes.String, nil)
+			if v, ok := timeoutValues[name]; ok {
+				attrs[name] = tftypes.NewValue(tftypes.String, v)
+			}
+		}
+		timeoutsValue = tftypes.NewValue(timeoutsType, attrs)
+	}
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":         id,
+		"name":       tftypes.NewValue(tftypes.String, "test"),
+		"project_id": tftypes.NewValue(tftypes.String, "pj1"),
+		"timeouts":   timeoutsValue,
+	})
+}
+
+func TestCrudResourceCreateWaitReady(t *testing.T) {
+	tests := map[string]struct {
+		timeouts map[string]string
+		err      error
+		id       string
+		summary  string
+	}{
+		"ready": {id: "ready"},
+		"not ready": {
+			err:     errors.New("failed"),
+			id:      "id",
+			summary: "Error waiting for test object: name=test",
+		},
+		"timeout": {
+			timeouts: map[string]string{"create": "1ms"},
+			id:       "id",
+			summary:  "Error waiting for test object: name=test",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := newCrudWaitTestResource(nil, func(ctx context.Context) (*crudTestObject, error) {
+				if test.timeouts != nil {
+					<-ctx.Done()
+					return nil, ctx.Err()
+				}
+				if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > defaultWaitTimeout {
+					t.Errorf("expected the wait to be bounded by the default timeout, got deadline %v", deadline)
+				}
+				if test.err != nil {
+					return nil, test.err
+				}
+				return &crudTestObject{id: "ready"}, nil
+			}, nil)
+
+			s := crudWaitTestSchema()
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: newCrudWaitTestValue(tftypes.NewValue(tftypes.String, nil), nil)}}
+			r.Create(ctx, resource.CreateRequest{
+				Plan: tfsdk.Plan{Schema: s, Raw: newCrudWaitTestValue(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), test.timeouts)},
+			}, resp)
+
+			if test.summary != "" {
+				if errs := resp.Diagnostics.Errors(); len(errs) != 1 || errs[0].Summary() != test.summary {
+					t.Fatalf("expected error %q, got %v", test.summary, resp.Diagnostics)
+				}
+			} else if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			// The created resource is kept in state when the wait fails.
+			var state crudWaitTestModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if state.Id.ValueString() != test.id {
+				t.Errorf("expected the ID %q in state, got %v", test.id, state)
+			}
+		})
+	}
+}
+
+func TestCrudResourceDeleteWaitDeleted(t *testing.T) {
+	tests := map[string]struct {
+		deleteErr error
+		timeouts  map[string]string
+		waited    bool
+		errors    int
+	}{
+		"deleted":   {waited: true},
+		"not found": {deleteErr: &ubicloud.Error{Status: "404 Not Found", StatusCode: 404}},
+		"timeout": {
+			timeouts: map[string]string{"delete": "1ms"},
+			waited:   true,
+			errors:   1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			waited := false
+			r := newCrudWaitTestResource(test.deleteErr, nil, func(ctx context.Context) error {
+				waited = true
+				if test.timeouts != nil {
+					<-ctx.Done()
+					return ctx.Err()
+				}
+				return nil
+			})
+
+			state := tfsdk.State{Schema: crudWaitTestSchema(), Raw: newCrudWaitTestValue(tftypes.NewValue(tftypes.String, "id"), test.timeouts)}
+			resp := &resource.DeleteResponse{State: state}
+			r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+			if waited != test.waited {
+				t.Errorf("expected waited to be %t", test.waited)
+			}
+			if got := resp.Diagnostics.ErrorsCount(); got != test.errors {
+				t.Errorf("expected %d errors, got %v", test.errors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCrudResourceUpdateNotSupported(t *testing.T) {
+	r := newCrudTestResource(nil)
+	resp := &resource.UpdateResponse{State: newCrudTestState("id")}
+	r.Update(context.Background(), resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: crudTestSchema, Raw: newCrudTestState("id").Raw},
+		State: newCrudTestState("id"),
+	}, resp)
+
+	if errs := resp.Diagnostics.Errors(); len(errs) != 1 || errs[0].Summary() != "Update of test object is not supported" {
+		t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+}
+
+func TestCrudResourceImportState(t *testing.T) {
+	tests := map[string]int{
+		"pj1,test":      0,
+		"pj1":           1,
+		"pj1,":          1,
+		",test":         1,
+		"pj1,test,more": 1,
+	}
+
+	for id, want := range tests {
+		t.Run(id, func(t *testing.T) {
+			r := newCrudTestResource(nil)
+			resp := &resource.ImportStateResponse{State: newCrudTestState("")}
+			r.ImportState(context.Background(), resource.ImportStateRequest{ID: id}, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != want {
+				t.Fatalf("expected %d errors, got %v", want, resp.Diagnostics)
+			}
+			if want > 0 && !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "project_id,name") {
+				t.Errorf("expected the format in the error, got %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCrudResourceConfigure(t *testing.T) {
+	r := newCrudTestResource(nil)
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{ProviderData: "not a client"}, resp)
+
+	if errs := resp.Diagnostics.Errors(); len(errs) != 1 || errs[0].Summary() != "Unexpected Resource Configure Type" {
+		t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	resp = &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{ProviderData: UbicloudClient{endpoint: "test"}}, resp)
+	if resp.Diagnostics.HasError() || r.uc == nil || r.uc.endpoint != "test" {
+		t.Errorf("expected the client to be configured, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = cidrType{}
+	_ basetypes.StringValuableWithSemanticEquals = cidrValue{}
+	_ basetypes.StringTypable                    = portRangeType{}
+	_ basetypes.StringValuableWithSemanticEquals = portRangeValue{}
+)
+
+// cidrType is a string type for CIDR blocks. Its values are semantically
+// equal when they describe the same network, so 10.0.0.5/24 written in the
+// configuration does not diff against 10.0.0.0/24 returned by the API.
+type cidrType struct {
+	basetypes.StringType
+}
+
+func (t cidrType) Equal(o attr.Type) bool {
+	other, ok := o.(cidrType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t cidrType) String() string {
+	return "cidrType"
+}
+
+func (t cidrType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return cidrValue{StringValue: in}, nil
+}
+
+func (t cidrType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return cidrValue{StringValue: stringValue}, nil
+}
+
+func (t cidrType) ValueType(_ context.Context) attr.Value {
+	return cidrValue{}
+}
+
+type cidrValue struct {
+	basetypes.StringValue
+}
+
+func (v cidrValue) Equal(o attr.Value) bool {
+	other, ok := o.(cidrValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v cidrValue) Type(_ context.Context) attr.Type {
+	return cidrType{}
+}
+
+func (v cidrValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(cidrValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to support@ubicloud.com.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return normalizeCidr(v.ValueString()) == normalizeCidr(newValue.ValueString()), diags
+}
+
+func cidrPointerValue(value *string) cidrValue {
+	return cidrValue{StringValue: basetypes.NewStringPointerValue(value)}
+}
+
+// portRangeType is a string type for port ranges. Its values are semantically
+// equal when they cover the same ports, so 22, 22-22 and 22..22 compare equal.
+type portRangeType struct {
+	basetypes.StringType
+}
+
+func (t portRangeType) Equal(o attr.Type) bool {
+	other, ok := o.(portRangeType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t portRangeType) String() string {
+	return "portRangeType"
+}
+
+func (t portRangeType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return portRangeValue{StringValue: in}, nil
+}
+
+func (t portRangeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return portRangeValue{StringValue: stringValue}, nil
+}
+
+func (t portRangeType) ValueType(_ context.Context) attr.Value {
+	return portRangeValue{}
+}
+
+type portRangeValue struct {
+	basetypes.StringValue
+}
+
+func (v portRangeValue) Equal(o attr.Value) bool {
+	other, ok := o.(portRangeValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v portRangeValue) Type(_ context.Context) attr.Type {
+	return portRangeType{}
+}
+
+func (v portRangeValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(portRangeValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to support@ubicloud.com.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return normalizePortRange(v.ValueString()) == normalizePortRange(newValue.ValueString()), diags
+}
+
+func portRangePointerValue(value *string) portRangeValue {
+	return portRangeValue{StringValue: basetypes.NewStringPointerValue(value)}
+}
+
+// normalizeCidr returns the network of a CIDR block in the format used by
+// the API. Values that cannot be parsed are returned unchanged.
+func normalizeCidr(value string) string {
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return value
+	}
+
+	return prefix.Masked().String()
+}
+
+// normalizePortRange returns a port range in the N..M format used by the API.
+// An empty port range covers all ports. Values that cannot be parsed are
+// returned unchanged.
+func normalizePortRange(value string) string {
+	if value == "" {
+		return firewallRuleAllPorts
+	}
+
+	first, last, err := parsePortRange(value)
+	if err != nil {
+		return value
+	}
+
+	return formatPortRange(first, last)
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCidrValueSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		prior    string
+		new      string
+		expected bool
+	}{
+		"same":            {prior: "10.0.0.0/8", new: "10.0.0.0/8", expected: true},
+		"host bits":       {prior: "10.0.0.5/24", new: "10.0.0.0/24", expected: true},
+		"ipv6 case":       {prior: "2001:DB8::/32", new: "2001:db8::/32", expected: true},
+		"ipv6 zeros":      {prior: "2001:0db8:0000::/48", new: "2001:db8::/48", expected: true},
+		"different":       {prior: "10.0.0.0/8", new: "10.0.0.0/16", expected: false},
+		"different ip":    {prior: "10.0.0.0/24", new: "10.0.1.0/24", expected: false},
+		"invalid same":    {prior: "garbage", new: "garbage", expected: true},
+		"invalid differs": {prior: "garbage", new: "10.0.0.0/8", expected: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			prior := cidrValue{StringValue: types.StringValue(test.prior)}
+			newValue := cidrValue{StringValue: types.StringValue(test.new)}
+
+			got, diags := prior.StringSemanticEquals(context.Background(), newValue)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestPortRangeValueSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		prior    string
+		new      string
+		expected bool
+	}{
+		"same":         {prior: "22..22", new: "22..22", expected: true},
+		"single port":  {prior: "22", new: "22..22", expected: true},
+		"dash":         {prior: "80-443", new: "80..443", expected: true},
+		"leading zero": {prior: "022..22", new: "22..22", expected: true},
+		"all ports":    {prior: "", new: "0..65535", expected: true},
+		"different":    {prior: "80..443", new: "80..444", expected: false},
+		"invalid":      {prior: "ssh", new: "22..22", expected: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			prior := portRangeValue{StringValue: types.StringValue(test.prior)}
+			newValue := portRangeValue{StringValue: types.StringValue(test.new)}
+
+			got, diags := prior.StringSemanticEquals(context.Background(), newValue)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestSemanticEqualsWrongType(t *testing.T) {
+	_, diags := cidrValue{StringValue: types.StringValue("10.0.0.0/8")}.StringSemanticEquals(context.Background(), types.StringValue("10.0.0.0/8"))
+	if !diags.HasError() {
+		t.Errorf("expected an error comparing a cidr with a plain string")
+	}
+
+	_, diags = portRangeValue{StringValue: types.StringValue("22..22")}.StringSemanticEquals(context.Background(), types.StringValue("22..22"))
+	if !diags.HasError() {
+		t.Errorf("expected an error comparing a port range with a plain string")
+	}
+}
+
+func TestFirewallRuleKey(t *testing.T) {
+	cidr := "10.0.0.5/24"
+	normalizedCidr := "10.0.0.0/24"
+	portRange := "22"
+	normalizedPortRange := "22..22"
+	empty := ""
+	allPorts := firewallRuleAllPorts
+
+	if firewallRuleKey(&cidr, &portRange) != firewallRuleKey(&normalizedCidr, &normalizedPortRange) {
+		t.Errorf("expected equivalent rules to share a key")
+	}
+	if firewallRuleKey(&normalizedCidr, nil) != firewallRuleKey(&normalizedCidr, &allPorts) {
+		t.Errorf("expected a missing port range to match all ports")
+	}
+	if firewallRuleKey(&normalizedCidr, &empty) != firewallRuleKey(&normalizedCidr, &allPorts) {
+		t.Errorf("expected an empty port range to match all ports")
+	}
+	if firewallRuleKey(&normalizedCidr, &normalizedPortRange) == firewallRuleKey(&normalizedCidr, &allPorts) {
+		t.Errorf("expected different port ranges to have different keys")
+	}
+}
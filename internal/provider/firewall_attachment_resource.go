@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewFirewallAttachmentResource() resource.Resource {
+	return &crudResource[firewallAttachmentModel, ubicloud_client.Firewall]{
+		typeName:         "firewall_attachment",
+		noun:             "firewall attachment",
+		schema:           firewallAttachmentResourceSchema,
+		importAttributes: []string{"project_id", "location", "firewall_name", "private_subnet_id"},
+		identifier:       firewallAttachmentResourceLogIdentifier,
+		create:           createFirewallAttachmentResource,
+		read:             readFirewallAttachmentResource,
+		delete:           deleteFirewallAttachmentResource,
+		setState:         setFirewallAttachmentStateResource,
+	}
+}
+
+// firewallAttachmentModel is hand-written, as an attachment has no object of
+// its own in the Ubicloud API to generate the schema from.
+type firewallAttachmentModel struct {
+	Id              types.String `tfsdk:"id"`
+	ProjectId       types.String `tfsdk:"project_id"`
+	Location        types.String `tfsdk:"location"`
+	FirewallName    types.String `tfsdk:"firewall_name"`
+	PrivateSubnetId types.String `tfsdk:"private_subnet_id"`
+}
+
+func firewallAttachmentResourceSchema(ctx context.Context) schema.Schema {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "ID of the attachment, in the format firewall_id,private_subnet_id",
+				MarkdownDescription: "ID of the attachment, in the format firewall_id,private_subnet_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "ID of the project",
+				MarkdownDescription: "ID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"location": schema.StringAttribute{
+				Required:            true,
+				Description:         "The Ubicloud location/region",
+				MarkdownDescription: "The Ubicloud location/region",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"firewall_name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the firewall",
+				MarkdownDescription: "Name of the firewall",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_subnet_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "ID of the private subnet",
+				MarkdownDescription: "ID of the private subnet",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+	s.Description = "Provides a Ubicloud FirewallAttachment resource. This can be used to attach firewalls to and detach them from private subnets."
+	return s
+}
+
+func createFirewallAttachmentResource(ctx context.Context, uc *UbicloudClient, state *firewallAttachmentModel) (*ubicloud_client.Firewall, error) {
+	body := ubicloud_client.AttachFirewallSubnetJSONRequestBody{
+		PrivateSubnetId: state.PrivateSubnetId.ValueString(),
+	}
+
+	firewallResp, err := uc.client.AttachFirewallSubnetWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.FirewallName.ValueString(), body)
+	if err != nil {
+		return nil, err
+	}
+	return firewallResp.JSON200, ubicloud.CheckResponse(firewallResp, firewallResp.Body, http.StatusOK)
+}
+
+// readFirewallAttachmentResource returns the attached firewall, as listed on
+// the private subnet. The attachment exists as long as it is listed.
+func readFirewallAttachmentResource(ctx context.Context, uc *UbicloudClient, state *firewallAttachmentModel) (*ubicloud_client.Firewall, error) {
+	privateSubnetResp, err := uc.client.GetPSDetailsWithIdWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.PrivateSubnetId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	if err := ubicloud.CheckResponse(privateSubnetResp, privateSubnetResp.Body, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	if privateSubnetResp.JSON200.Firewalls != nil {
+		for _, f := range *privateSubnetResp.JSON200.Firewalls {
+			if f.Name != nil && *f.Name == state.FirewallName.ValueString() {
+				return &f, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("firewall is not attached: %w", ubicloud.ErrNotFound)
+}
+
+func deleteFirewallAttachmentResource(ctx context.Context, uc *UbicloudClient, state *firewallAttachmentModel) error {
+	body := ubicloud_client.DetachFirewallSubnetJSONRequestBody{
+		PrivateSubnetId: state.PrivateSubnetId.ValueString(),
+	}
+
+	firewallResp, err := uc.client.DetachFirewallSubnetWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.FirewallName.ValueString(), body)
+	if err != nil {
+		return err
+	}
+	return ubicloud.CheckResponse(firewallResp, firewallResp.Body, http.StatusOK)
+}
+
+func setFirewallAttachmentStateResource(_ context.Context, firewall *ubicloud_client.Firewall, state *firewallAttachmentModel) diag.Diagnostics {
+	if firewall.Id != nil {
+		state.Id = types.StringValue(fmt.Sprintf("%s,%s", *firewall.Id, state.PrivateSubnetId.ValueString()))
+	}
+	return nil
+}
+
+func firewallAttachmentResourceLogIdentifier(state *firewallAttachmentModel) string {
+	return fmt.Sprintf("project_id=%s, location=%s, firewall_name=%s, private_subnet_id=%s", state.ProjectId.ValueString(), state.Location.ValueString(), state.FirewallName.ValueString(), state.PrivateSubnetId.ValueString())
+}
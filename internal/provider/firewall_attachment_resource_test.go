@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+)
+
+func TestAccFirewallAttachmentResource(t *testing.T) {
+	fwName := GetRandomResourceName(t, "fw")
+	psName := GetRandomResourceName(t, "ps")
+	replacementFwName := GetRandomResourceName(t, "fw")
+	resourceConfig := func(firewall string) string {
+		return testAccPrivateSubnetConfig(psName, psName+"-fw") + fmt.Sprintf(`
+    resource "ubicloud_firewall" "attached" {
+      project_id  = "%s"
+      location    = "%s"
+      name        = "%s"
+      description = "Terraform acceptance testing"
+    }
+
+    resource "ubicloud_firewall" "replacement" {
+      project_id  = "%s"
+      location    = "%s"
+      name        = "%s"
+      description = "Terraform acceptance testing"
+    }
+
+    resource "ubicloud_firewall_attachment" "testacc" {
+      project_id        = ubicloud_firewall.%s.project_id
+      location          = ubicloud_firewall.%s.location
+      firewall_name     = ubicloud_firewall.%s.name
+      private_subnet_id = ubicloud_private_subnet.testacc.id
+    }
+    `, GetTestAccProjectId(), GetTestAccLocation(), fwName, GetTestAccProjectId(), GetTestAccLocation(), replacementFwName, firewall, firewall, firewall)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckFirewallAttachmentDestroy(t),
+			testAccCheckPrivateSubnetDestroy(t),
+			testAccCheckFirewallDestroy(t),
+		),
+		Steps: []resource.TestStep{
+			// Test Create and Read
+			{
+				Config: providerConfig + resourceConfig("attached"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ubicloud_firewall_attachment.testacc", "id"),
+					resource.TestCheckResourceAttr("ubicloud_firewall_attachment.testacc", "project_id", GetTestAccProjectId()),
+					resource.TestCheckResourceAttr("ubicloud_firewall_attachment.testacc", "location", GetTestAccLocation()),
+					resource.TestCheckResourceAttr("ubicloud_firewall_attachment.testacc", "firewall_name", fwName),
+					resource.TestCheckResourceAttrPair("ubicloud_firewall_attachment.testacc", "private_subnet_id", "ubicloud_private_subnet.testacc", "id"),
+				),
+			},
+			// Test ImportState
+			{
+				ResourceName: "ubicloud_firewall_attachment.testacc",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["ubicloud_firewall_attachment.testacc"]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", "ubicloud_firewall_attachment.testacc")
+					}
+					return fmt.Sprintf("%s,%s,%s,%s", GetTestAccProjectId(), GetTestAccLocation(), fwName, rs.Primary.Attributes["private_subnet_id"]), nil
+				},
+				ImportStateVerify: true,
+			},
+			// Test that attaching another firewall replaces the attachment
+			{
+				Config: providerConfig + resourceConfig("replacement"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ubicloud_firewall_attachment.testacc", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ubicloud_firewall_attachment.testacc", "firewall_name", replacementFwName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckFirewallAttachmentDestroy checks that the firewalls of
+// attachments are not listed on their private subnets anymore, if the
+// subnets still exist.
+func testAccCheckFirewallAttachmentDestroy(t *testing.T) resource.TestCheckFunc {
+	return testAccCheckDestroy(t, "ubicloud_firewall_attachment", func(ctx context.Context, client *ubicloud.Client, attrs map[string]string) (bool, error) {
+		resp, err := client.GetPSDetailsWithIdWithResponse(ctx, attrs["project_id"], attrs["location"], attrs["private_subnet_id"])
+		if err != nil {
+			return false, err
+		}
+		if resp.StatusCode() == http.StatusNotFound {
+			return false, nil
+		}
+		if resp.JSON200 == nil {
+			return false, fmt.Errorf("unexpected HTTP status code %d: %s", resp.StatusCode(), resp.Body)
+		}
+
+		if resp.JSON200.Firewalls != nil {
+			for _, fw := range *resp.JSON200.Firewalls {
+				if fw.Name != nil && *fw.Name == attrs["firewall_name"] {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	})
+}
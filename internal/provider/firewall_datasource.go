@@ -6,14 +6,8 @@ import (
 	"net/http"
 
 	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/datasource_firewall"
-	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/datasource_vm"
-	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_client"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -80,58 +74,14 @@ func (d *firewallDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	assignStr(firewallResp.JSON200.Id, &state.Id)
-	assignStr(firewallResp.JSON200.Name, &state.Name)
-	assignStr(firewallResp.JSON200.Description, &state.Description)
-	assignStr(firewallResp.JSON200.Location, &state.Location)
-
-	firewallRulesListValue, fwRulesDiags := GetFirewallRulesState(ctx, firewallResp.JSON200.FirewallRules)
-	resp.Diagnostics.Append(fwRulesDiags...)
+	resp.Diagnostics.Append(mapState(ctx, firewallResp.JSON200, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.FirewallRules = firewallRulesListValue
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func GetFirewallsState(ctx context.Context, firewalls *[]ubicloud_client.Firewall) (basetypes.ListValue, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	firewallsValue := datasource_vm.FirewallsValue{}
-	var firewallsValues []datasource_vm.FirewallsValue
-	firewallRulesValue := datasource_vm.FirewallRulesValue{}
-	if firewalls != nil && len(*firewalls) > 0 {
-		for _, f := range *firewalls {
-
-			fwRules, fwRulesDiag := GetFirewallRulesState(ctx, f.FirewallRules)
-			diags.Append(fwRulesDiag...)
-			if diags.HasError() {
-				return basetypes.NewListUnknown(firewallRulesValue.Type(ctx)), diags
-			}
-
-			fw := datasource_vm.NewFirewallsValueMust(firewallsValue.AttributeTypes(ctx), map[string]attr.Value{
-				"id":             types.StringPointerValue(f.Id),
-				"location":       types.StringPointerValue(f.Location),
-				"name":           types.StringPointerValue(f.Name),
-				"description":    types.StringPointerValue(f.Description),
-				"firewall_rules": fwRules,
-			})
-			firewallsValues = append(firewallsValues, fw)
-		}
-	} else {
-		firewallsValues = []datasource_vm.FirewallsValue{}
-	}
-	firewallsListValue, diag := types.ListValueFrom(ctx, firewallsValue.Type(ctx), firewallsValues)
-	diags.Append(diag...)
-	if diags.HasError() {
-		return basetypes.NewListUnknown(firewallRulesValue.Type(ctx)), diags
-	}
-
-	return firewallsListValue, diags
-}
-
 func firewallDataSourceLogIdentifier(state *datasource_firewall.FirewallModel) string {
 	return fmt.Sprintf("project_id=%s, location=%s, firewall_name=%s", state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
 }
@@ -8,9 +8,11 @@ import (
 )
 
 func TestAccFirewallDataSource(t *testing.T) {
+	resName := GetRandomResourceName(t, "fw")
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
 		PreCheck:                 func() { TestAccPreCheck(t) },
+		CheckDestroy:             testAccCheckFirewallDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: providerConfig +
@@ -18,7 +20,7 @@ func TestAccFirewallDataSource(t *testing.T) {
         resource "ubicloud_firewall" "testacc" {
           project_id  = "%s"
           location    = "%s"
-          name        = "tf-testacc"
+          name        = "%s"
           description = "Terraform acceptance testing"
         }
         
@@ -26,12 +28,12 @@ func TestAccFirewallDataSource(t *testing.T) {
           project_id = ubicloud_firewall.testacc.project_id
           location   = ubicloud_firewall.testacc.location
           name       = ubicloud_firewall.testacc.name
-        }`, GetTestAccProjectId(), GetTestAccLocation()),
+        }`, GetTestAccProjectId(), GetTestAccLocation(), resName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.ubicloud_firewall.testacc", "id"),
 					resource.TestCheckResourceAttr("data.ubicloud_firewall.testacc", "project_id", GetTestAccProjectId()),
 					resource.TestCheckResourceAttr("data.ubicloud_firewall.testacc", "location", GetTestAccLocation()),
-					resource.TestCheckResourceAttr("data.ubicloud_firewall.testacc", "name", "tf-testacc"),
+					resource.TestCheckResourceAttr("data.ubicloud_firewall.testacc", "name", resName),
 					resource.TestCheckResourceAttr("data.ubicloud_firewall.testacc", "description", "Terraform acceptance testing"),
 					resource.TestCheckResourceAttr("data.ubicloud_firewall.testacc", "firewall_rules.#", "0"),
 				),
@@ -4,195 +4,354 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/resource_firewall"
-	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_client"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var (
-	_ resource.Resource                = &firewallResource{}
-	_ resource.ResourceWithConfigure   = &firewallResource{}
-	_ resource.ResourceWithImportState = &firewallResource{}
-)
+// firewallRuleAllPorts is the port range the API assigns to rules created
+// without one.
+const firewallRuleAllPorts = "0..65535"
 
 func NewFirewallResource() resource.Resource {
-	return &firewallResource{}
+	return &crudResource[firewallResourceModel, ubicloud_client.Firewall]{
+		typeName:         "firewall",
+		noun:             "firewall",
+		schema:           firewallResourceSchema,
+		importAttributes: []string{"project_id", "location", "name"},
+		identifier:       firewallResourceLogIdentifier,
+		create:           createFirewallResource,
+		afterCreate:      createFirewallResourceRules,
+		read:             getFirewall,
+		update:           updateFirewallResource,
+		delete:           deleteFirewallResource,
+		setState:         setFirewallStateResource,
+	}
 }
 
-type firewallResource struct {
-	uc *UbicloudClient
+// firewallResourceModel mirrors resource_firewall.FirewallModel and adds the
+// rule blocks, which are not part of the generated schema.
+type firewallResourceModel struct {
+	Description   types.String `tfsdk:"description"`
+	FirewallRules types.List   `tfsdk:"firewall_rules"`
+	Id            types.String `tfsdk:"id"`
+	Location      types.String `tfsdk:"location"`
+	Name          types.String `tfsdk:"name"`
+	ProjectId     types.String `tfsdk:"project_id"`
+	Rule          types.Set    `tfsdk:"rule"`
 }
 
-func (r *firewallResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	uc, ok := req.ProviderData.(UbicloudClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *UbicloudClient, got: %T. Please report this issue to support@ubicloud.com.", req.ProviderData),
-		)
-
-		return
-	}
-
-	r.uc = &uc
+type firewallResourceRuleModel struct {
+	Cidr      cidrValue      `tfsdk:"cidr"`
+	PortRange portRangeValue `tfsdk:"port_range"`
 }
 
-func (r *firewallResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_firewall"
+var firewallResourceRuleAttrTypes = map[string]attr.Type{
+	"cidr":       cidrType{},
+	"port_range": portRangeType{},
 }
 
-func (r *firewallResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_firewall.FirewallResourceSchema(ctx)
-	resp.Schema.Description = "Provides a Ubicloud Firewall resource. This can be used to create and delete firewalls."
+func firewallResourceSchema(ctx context.Context) schema.Schema {
+	s := resource_firewall.FirewallResourceSchema(ctx)
+	s.Description = "Provides a Ubicloud Firewall resource. This can be used to create, update and delete firewalls."
+
+	// Name and description can be changed in place, the project and
+	// location of a firewall cannot.
+	requiresReplaceStringAttributes(&s, "project_id", "location")
+	useStateForUnknownStringAttributes(&s, "id")
+
+	s.Blocks = map[string]schema.Block{
+		"rule": schema.SetNestedBlock{
+			Description: "Firewall rules managed authoritatively by this resource. When at least one rule is set, rules of the firewall that are not " +
+				"listed are deleted. Do not combine with ubicloud_firewall_rule resources for the same firewall.",
+			MarkdownDescription: "Firewall rules managed authoritatively by this resource. When at least one `rule` is set, rules of the firewall that are not " +
+				"listed are deleted. Do not combine with `ubicloud_firewall_rule` resources for the same firewall.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"cidr": schema.StringAttribute{
+						CustomType:          cidrType{},
+						Required:            true,
+						Description:         "CIDR of the firewall rule",
+						MarkdownDescription: "CIDR of the firewall rule",
+						Validators: []validator.String{
+							cidrValidator{},
+						},
+					},
+					"port_range": schema.StringAttribute{
+						CustomType:          portRangeType{},
+						Optional:            true,
+						Description:         "Port range of the firewall rule. All ports if not set",
+						MarkdownDescription: "Port range of the firewall rule. All ports if not set",
+						Validators: []validator.String{
+							portRangeValidator{},
+						},
+					},
+				},
+			},
+		},
+	}
+	return s
 }
 
-func (r *firewallResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var state resource_firewall.FirewallModel
-
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+func createFirewallResource(ctx context.Context, uc *UbicloudClient, state *firewallResourceModel) (*ubicloud_client.Firewall, error) {
 	body := ubicloud_client.CreateFirewallJSONRequestBody{}
 	if state.Description.ValueString() != "" {
 		body.Description = state.Description.ValueStringPointer()
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Creating firewall: project_id=%s", state.ProjectId.ValueString()))
-	firewallResp, err := r.uc.client.CreateFirewallWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString(), body)
+	firewallResp, err := uc.client.CreateFirewallWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString(), body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error creating firewall: project_id=%s", state.ProjectId.ValueString()),
-			err.Error(),
-		)
-		return
+		return nil, err
 	}
+	return firewallResp.JSON200, ubicloud.CheckResponse(firewallResp, firewallResp.Body, http.StatusOK)
+}
 
-	if firewallResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code creating firewall",
-			fmt.Sprintf("Received %s creating new firewall: project_id=%s. Details: %s", firewallResp.Status(), state.ProjectId.ValueString(), firewallResp.Body))
-		return
+// createFirewallResourceRules creates the rules of a new firewall, once the
+// firewall is saved in state, so that a failing rule does not leave the
+// firewall untracked.
+func createFirewallResourceRules(ctx context.Context, uc *UbicloudClient, plan *firewallResourceModel) (*ubicloud_client.Firewall, error) {
+	var rules []firewallResourceRuleModel
+	if diags := plan.Rule.ElementsAs(ctx, &rules, false); diags.HasError() {
+		return nil, diagnosticsError{diags}
+	}
+	if len(rules) == 0 {
+		return nil, nil
 	}
 
-	assignStr(firewallResp.JSON200.Id, &state.Id)
-	assignStr(firewallResp.JSON200.Name, &state.Name)
-	assignStr(firewallResp.JSON200.Description, &state.Description)
+	return reconcileFirewallRules(ctx, uc, plan, rules, nil)
+}
 
-	firewallRulesListValue, fwRulesDiags := GetFirewallRulesState(ctx, firewallResp.JSON200.FirewallRules)
-	resp.Diagnostics.Append(fwRulesDiags...)
-	if resp.Diagnostics.HasError() {
-		return
+func updateFirewallResource(ctx context.Context, uc *UbicloudClient, plan *firewallResourceModel, state *firewallResourceModel) (*ubicloud_client.Firewall, error) {
+	var rules, managedRules []firewallResourceRuleModel
+	diags := plan.Rule.ElementsAs(ctx, &rules, false)
+	diags.Append(state.Rule.ElementsAs(ctx, &managedRules, false)...)
+	if diags.HasError() {
+		return nil, diagnosticsError{diags}
 	}
 
-	state.FirewallRules = firewallRulesListValue
+	// The description and rules are changed with the current name and the
+	// firewall is renamed last, so that a failure leaves it under the name in
+	// state.
+	current := *plan
+	current.Name = state.Name
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-}
+	var firewall *ubicloud_client.Firewall
 
-func (r *firewallResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_firewall.FirewallModel
+	if !plan.Description.IsUnknown() && !plan.Description.Equal(state.Description) {
+		body := ubicloud_client.UpdateFirewallJSONRequestBody{
+			Description: plan.Description.ValueStringPointer(),
+		}
 
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+		firewallResp, err := uc.client.UpdateFirewallWithResponse(ctx, current.ProjectId.ValueString(), current.Location.ValueString(), current.Name.ValueString(), body)
+		if err != nil {
+			return nil, fmt.Errorf("updating firewall description: %w", err)
+		}
+		if err := ubicloud.CheckResponse(firewallResp, firewallResp.Body, http.StatusOK); err != nil {
+			return nil, fmt.Errorf("updating firewall description: %w", err)
+		}
 
-	tflog.Debug(ctx, fmt.Sprintf("Reading firewall: %s", firewallResourceLogIdentifier(&state)))
-	firewallResp, err := r.uc.client.GetFirewallDetailsWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error reading firewall: %s", firewallResourceLogIdentifier(&state)),
-			err.Error(),
-		)
-		return
+		firewall = firewallResp.JSON200
 	}
 
-	if firewallResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading firewall",
-			fmt.Sprintf("Received %s for firewall: %s. Details: %s", firewallResp.Status(), firewallResourceLogIdentifier(&state), firewallResp.Body))
-		return
+	if !plan.Rule.Equal(state.Rule) {
+		reconciled, err := reconcileFirewallRules(ctx, uc, &current, rules, managedRules)
+		if err != nil {
+			return nil, err
+		}
+
+		firewall = reconciled
 	}
 
-	assignStr(firewallResp.JSON200.Name, &state.Name)
-	assignStr(firewallResp.JSON200.Location, &state.Location)
-	assignStr(firewallResp.JSON200.Description, &state.Description)
+	if !plan.Name.Equal(state.Name) {
+		body := ubicloud_client.RenameFirewallJSONRequestBody{
+			Name: plan.Name.ValueString(),
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Renaming firewall: %s, new_name=%s", firewallResourceLogIdentifier(state), plan.Name.ValueString()))
+		firewallResp, err := uc.client.RenameFirewallWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString(), body)
+		if err != nil {
+			return nil, fmt.Errorf("renaming firewall: %w", err)
+		}
+		if err := ubicloud.CheckResponse(firewallResp, firewallResp.Body, http.StatusOK); err != nil {
+			return nil, fmt.Errorf("renaming firewall: %w", err)
+		}
+
+		firewall = firewallResp.JSON200
+	}
 
-	firewallRulesListValue, fwRulesDiags := GetFirewallRulesState(ctx, firewallResp.JSON200.FirewallRules)
-	resp.Diagnostics.Append(fwRulesDiags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if firewall == nil {
+		// Only computed attributes differ, there is nothing to send to the API.
+		return nil, nil
 	}
 
-	state.FirewallRules = firewallRulesListValue
+	if plan.Description.IsUnknown() {
+		plan.Description = state.Description
+	}
+	if plan.Id.IsUnknown() {
+		plan.Id = state.Id
+	}
+	return firewall, nil
+}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+func deleteFirewallResource(ctx context.Context, uc *UbicloudClient, state *firewallResourceModel) error {
+	firewallResp, err := uc.client.DeleteFirewallWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
+	if err != nil {
+		return err
+	}
+	return ubicloud.CheckResponse(firewallResp, firewallResp.Body, http.StatusNoContent)
 }
 
-func (r *firewallResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state resource_firewall.FirewallModel
+func firewallResourceLogIdentifier(state *firewallResourceModel) string {
+	return fmt.Sprintf("project_id=%s, location=%s, name=%s", state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
+}
 
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
+func getFirewall(ctx context.Context, uc *UbicloudClient, state *firewallResourceModel) (*ubicloud_client.Firewall, error) {
+	firewallResp, err := uc.client.GetFirewallDetailsWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
+	if err != nil {
+		return nil, err
 	}
-
-	resp.Diagnostics.AddError(
-		"Update of firewall is not supported",
-		fmt.Sprintf("Cannot update firewall: %s", firewallResourceLogIdentifier(&state)))
+	return firewallResp.JSON200, ubicloud.CheckResponse(firewallResp, firewallResp.Body, http.StatusOK)
 }
 
-func (r *firewallResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_firewall.FirewallModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
+// reconcileFirewallRules creates the desired rules missing from the firewall
+// and deletes the ones that are not desired, leaving unchanged rules alone. With
+// no desired rules only the previously managed ones are deleted, so that
+// rules from ubicloud_firewall_rule resources survive. The firewall is
+// returned as it is after all changes.
+func reconcileFirewallRules(ctx context.Context, uc *UbicloudClient, state *firewallResourceModel, desired []firewallResourceRuleModel, managed []firewallResourceRuleModel) (*ubicloud_client.Firewall, error) {
+	unlock, err := uc.lockFirewall(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
+	if err != nil {
+		return nil, err
 	}
+	defer unlock()
 
-	tflog.Debug(ctx, fmt.Sprintf("Deleting firewall: %s", firewallResourceLogIdentifier(&state)))
-	firewallResp, err := r.uc.client.DeleteFirewallWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
+	firewall, err := getFirewall(ctx, uc, state)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error deleting firewall: %s", firewallResourceLogIdentifier(&state)),
-			err.Error(),
-		)
-		return
+		return nil, err
+	}
+
+	desiredKeys := map[string]bool{}
+	for _, rule := range desired {
+		desiredKeys[firewallRuleKey(rule.Cidr.ValueStringPointer(), rule.PortRange.ValueStringPointer())] = true
+	}
+	managedKeys := map[string]bool{}
+	for _, rule := range managed {
+		managedKeys[firewallRuleKey(rule.Cidr.ValueStringPointer(), rule.PortRange.ValueStringPointer())] = true
+	}
+
+	existingKeys := map[string]bool{}
+	if firewall.FirewallRules != nil {
+		for _, rule := range *firewall.FirewallRules {
+			key := firewallRuleKey(rule.Cidr, rule.PortRange)
+			existingKeys[key] = true
+			if rule.Id == nil || desiredKeys[key] || (len(desired) == 0 && !managedKeys[key]) {
+				continue
+			}
+
+			tflog.Debug(ctx, fmt.Sprintf("Deleting firewall rule: %s, rule_id=%s", firewallResourceLogIdentifier(state), *rule.Id))
+			ruleResp, err := uc.client.DeleteFirewallRuleWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString(), *rule.Id)
+			if err != nil {
+				return nil, fmt.Errorf("deleting firewall rule %s: %w", *rule.Id, err)
+			}
+			if err := ubicloud.CheckResponse(ruleResp, ruleResp.Body, http.StatusNoContent, http.StatusNotFound); err != nil {
+				return nil, fmt.Errorf("deleting firewall rule %s: %w", *rule.Id, err)
+			}
+		}
 	}
 
-	if firewallResp.StatusCode() != http.StatusNoContent && firewallResp.StatusCode() != http.StatusNotFound {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code deleting firewall",
-			fmt.Sprintf("Received %s deleting firewall: %s. Details: %s", firewallResp.Status(), firewallResourceLogIdentifier(&state), firewallResp.Body))
-		return
+	for _, rule := range desired {
+		key := firewallRuleKey(rule.Cidr.ValueStringPointer(), rule.PortRange.ValueStringPointer())
+		if existingKeys[key] {
+			continue
+		}
+		existingKeys[key] = true
+
+		body := ubicloud_client.CreateFirewallRuleJSONRequestBody{
+			Cidr: rule.Cidr.ValueString(),
+		}
+		if rule.PortRange.ValueString() != "" {
+			body.PortRange = rule.PortRange.ValueStringPointer()
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Creating firewall rule: %s, cidr=%s, port_range=%s", firewallResourceLogIdentifier(state), rule.Cidr.ValueString(), rule.PortRange.ValueString()))
+		ruleResp, err := uc.client.CreateFirewallRuleWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString(), body)
+		if err != nil {
+			return nil, fmt.Errorf("creating firewall rule cidr=%s, port_range=%s: %w", rule.Cidr.ValueString(), rule.PortRange.ValueString(), err)
+		}
+		if err := ubicloud.CheckResponse(ruleResp, ruleResp.Body, http.StatusOK); err != nil {
+			return nil, fmt.Errorf("creating firewall rule cidr=%s, port_range=%s: %w", rule.Cidr.ValueString(), rule.PortRange.ValueString(), err)
+		}
 	}
+
+	return getFirewall(ctx, uc, state)
 }
 
-func (r *firewallResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
+// setFirewallStateResource maps the firewall into state. The rule set is
+// only populated when rules are managed by the resource, as desired in the
+// rule set of state, in which case any rule present on the firewall shows
+// up, including ones created outside of Terraform. A rule keeps the
+// representation of the matching desired rule, so an omitted port range does
+// not diff against the all-ports range.
+func setFirewallStateResource(ctx context.Context, firewall *ubicloud_client.Firewall, state *firewallResourceModel) diag.Diagnostics {
+	var desired []firewallResourceRuleModel
+	diags := state.Rule.ElementsAs(ctx, &desired, false)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(mapState(ctx, firewall, state)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	desiredByKey := map[string]firewallResourceRuleModel{}
+	for _, rule := range desired {
+		desiredByKey[firewallRuleKey(rule.Cidr.ValueStringPointer(), rule.PortRange.ValueStringPointer())] = rule
+	}
 
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: project_id,location,name,id. Got: %q", req.ID),
-		)
-		return
+	rules := []firewallResourceRuleModel{}
+	if len(desired) > 0 && firewall.FirewallRules != nil {
+		for _, r := range *firewall.FirewallRules {
+			if rule, ok := desiredByKey[firewallRuleKey(r.Cidr, r.PortRange)]; ok {
+				rules = append(rules, rule)
+				continue
+			}
+			rules = append(rules, firewallResourceRuleModel{
+				Cidr:      cidrPointerValue(r.Cidr),
+				PortRange: portRangePointerValue(r.PortRange),
+			})
+		}
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("location"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[2])...)
+	ruleSetValue, diagsRule := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: firewallResourceRuleAttrTypes}, rules)
+	diags.Append(diagsRule...)
+	if diags.HasError() {
+		return diags
+	}
+
+	state.Rule = ruleSetValue
+	return diags
 }
 
-func firewallResourceLogIdentifier(state *resource_firewall.FirewallModel) string {
-	return fmt.Sprintf("project_id=%s, location=%s, name=%s", state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
+// firewallRuleKey identifies a firewall rule by its normalized CIDR and port
+// range, so equivalent representations of a rule share the same key. A
+// missing port range is the same as allowing all ports.
+func firewallRuleKey(cidr *string, portRange *string) string {
+	key := ""
+	if cidr != nil {
+		key = normalizeCidr(*cidr)
+	}
+	if portRange == nil {
+		return key + "," + firewallRuleAllPorts
+	}
+	return key + "," + normalizePortRange(*portRange)
 }
@@ -1,16 +1,23 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
 )
 
 func TestAccFirewallResource(t *testing.T) {
+	resName := GetRandomResourceName(t, "fw")
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		CheckDestroy:             testAccCheckFirewallDestroy(t),
 		Steps: []resource.TestStep{
 			// Test Create and Read
 			{
@@ -19,26 +26,198 @@ func TestAccFirewallResource(t *testing.T) {
         resource "ubicloud_firewall" "testacc" {
           project_id  = "%s"
           location    = "%s"
-          name        = "tf-testacc"
+          name        = "%s"
           description = "Terraform acceptance testing"
-        }`, GetTestAccProjectId(), GetTestAccLocation()),
+        }`, GetTestAccProjectId(), GetTestAccLocation(), resName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("ubicloud_firewall.testacc", "id"),
 					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "project_id", GetTestAccProjectId()),
 					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "location", GetTestAccLocation()),
-					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "name", "tf-testacc"),
+					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "name", resName),
 					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "description", "Terraform acceptance testing"),
 					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "firewall_rules.#", "0"),
 				),
 			},
 			// Test ImportState
 			{
-				ResourceName:        "ubicloud_firewall.testacc",
-				ImportState:         true,
+				ResourceName: "ubicloud_firewall.testacc",
+				ImportState:  true,
 				ImportStateIdFunc: func(state *terraform.State) (string, error) {
-					return fmt.Sprintf("%s,%s,%s", GetTestAccProjectId(), GetTestAccLocation(), "tf-testacc"), nil
+					return fmt.Sprintf("%s,%s,%s", GetTestAccProjectId(), GetTestAccLocation(), resName), nil
 				},
+				ImportStateVerify: true,
+			},
+			// Test in-place Update of name and description
+			{
+				Config: providerConfig +
+					fmt.Sprintf(`
+        resource "ubicloud_firewall" "testacc" {
+          project_id  = "%s"
+          location    = "%s"
+          name        = "%s-renamed"
+          description = "Terraform acceptance testing, updated"
+        }`, GetTestAccProjectId(), GetTestAccLocation(), resName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ubicloud_firewall.testacc", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ubicloud_firewall.testacc", "id"),
+					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "name", resName+"-renamed"),
+					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "description", "Terraform acceptance testing, updated"),
+				),
 			},
 		},
 	})
 }
+
+// TestAccFirewallResourceUpdateFailure checks that a firewall whose update
+// fails is still tracked, under the name the API knows it by.
+func TestAccFirewallResourceUpdateFailure(t *testing.T) {
+	server := testAccFakeAPI(t)
+	resName := GetRandomResourceName(t, "fw")
+	resourceConfig := func(name string, description string) string {
+		return providerConfig + fmt.Sprintf(`
+        resource "ubicloud_firewall" "testacc" {
+          project_id  = "%s"
+          location    = "%s"
+          name        = "%s"
+          description = "%s"
+        }`, GetTestAccProjectId(), GetTestAccLocation(), name, description)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		CheckDestroy:             testAccCheckFirewallDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig(resName, "Terraform acceptance testing"),
+			},
+			// The description is changed before the rename, which doesn't
+			// happen when the change fails.
+			{
+				PreConfig: func() {
+					server.InjectError(http.MethodPatch, "/project/*/location/*/firewall/*", http.StatusBadRequest, 1)
+				},
+				Config:      resourceConfig(resName+"-renamed", "Terraform acceptance testing, updated"),
+				ExpectError: regexp.MustCompile("updating firewall description"),
+			},
+			{
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check:              resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "name", resName),
+			},
+			// The rename fails after the description is changed.
+			{
+				PreConfig: func() {
+					server.InjectError(http.MethodPost, "/project/*/location/*/firewall/*/rename", http.StatusBadRequest, 1)
+				},
+				Config:      resourceConfig(resName+"-renamed", "Terraform acceptance testing, updated"),
+				ExpectError: regexp.MustCompile("renaming firewall"),
+			},
+			{
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "name", resName),
+					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "description", "Terraform acceptance testing, updated"),
+				),
+			},
+			{
+				Config: resourceConfig(resName+"-renamed", "Terraform acceptance testing, updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ubicloud_firewall.testacc", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "name", resName+"-renamed"),
+			},
+		},
+	})
+}
+
+func TestAccFirewallResourceRules(t *testing.T) {
+	resName := GetRandomResourceName(t, "fw")
+	resourceConfig := func(rules string) string {
+		return providerConfig + fmt.Sprintf(`
+        resource "ubicloud_firewall" "testacc" {
+          project_id  = "%s"
+          location    = "%s"
+          name        = "%s"
+          %s
+        }`, GetTestAccProjectId(), GetTestAccLocation(), resName, rules)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		CheckDestroy:             testAccCheckFirewallDestroy(t),
+		Steps: []resource.TestStep{
+			// Test Create with rules
+			{
+				Config: resourceConfig(`
+          rule {
+            cidr       = "0.0.0.0/0"
+            port_range = "22..22"
+          }
+          rule {
+            cidr = "10.0.0.0/8"
+          }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "rule.#", "2"),
+					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "firewall_rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("ubicloud_firewall.testacc", "rule.*", map[string]string{
+						"cidr":       "0.0.0.0/0",
+						"port_range": "22..22",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("ubicloud_firewall.testacc", "rule.*", map[string]string{
+						"cidr": "10.0.0.0/8",
+					}),
+				),
+			},
+			// Test Update of a single rule
+			{
+				Config: resourceConfig(`
+          rule {
+            cidr       = "0.0.0.0/0"
+            port_range = "443..443"
+          }
+          rule {
+            cidr = "10.0.0.0/8"
+          }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ubicloud_firewall.testacc", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "rule.#", "2"),
+					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "firewall_rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("ubicloud_firewall.testacc", "rule.*", map[string]string{
+						"cidr":       "0.0.0.0/0",
+						"port_range": "443..443",
+					}),
+				),
+			},
+			// Test removal of all rules
+			{
+				Config: resourceConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "rule.#", "0"),
+					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "firewall_rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFirewallDestroy(t *testing.T) resource.TestCheckFunc {
+	return testAccCheckDestroy(t, "ubicloud_firewall", func(ctx context.Context, client *ubicloud.Client, attrs map[string]string) (bool, error) {
+		resp, err := client.GetFirewallDetailsWithResponse(ctx, attrs["project_id"], attrs["location"], attrs["name"])
+		if err != nil {
+			return false, err
+		}
+		return testAccExists(resp.StatusCode(), resp.Body)
+	})
+}
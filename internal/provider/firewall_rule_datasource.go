@@ -6,14 +6,8 @@ import (
 	"net/http"
 
 	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/datasource_firewall_rule"
-	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/datasource_vm"
-	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_client"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -82,38 +76,12 @@ func (d *firewallRuleDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	assignStr(firewallRuleResp.JSON200.Id, &state.Id)
-	assignStr(firewallRuleResp.JSON200.Cidr, &state.Cidr)
-	assignStr(firewallRuleResp.JSON200.PortRange, &state.PortRange)
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-}
-
-func GetFirewallRulesState(ctx context.Context, firewallRules *[]ubicloud_client.FirewallRule) (basetypes.ListValue, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	// Firewalls
-	var firewallRulesValues []datasource_vm.FirewallRulesValue
-	firewallRulesValue := datasource_vm.FirewallRulesValue{}
-	if firewallRules != nil && len(*firewallRules) > 0 {
-		for _, r := range *firewallRules {
-			fr := datasource_vm.NewFirewallRulesValueMust(firewallRulesValue.AttributeTypes(ctx), map[string]attr.Value{
-				"id":         types.StringPointerValue(r.Id),
-				"cidr":       types.StringPointerValue(r.Cidr),
-				"port_range": types.StringPointerValue(r.PortRange),
-			})
-			firewallRulesValues = append(firewallRulesValues, fr)
-		}
-	} else {
-		firewallRulesValues = []datasource_vm.FirewallRulesValue{}
-	}
-
-	fwRules, diag := types.ListValueFrom(ctx, firewallRulesValue.Type(ctx), firewallRulesValues)
-	diags.Append(diag...)
-	if diags.HasError() {
-		return basetypes.NewListUnknown(firewallRulesValue.Type(ctx)), diags
+	resp.Diagnostics.Append(mapState(ctx, firewallRuleResp.JSON200, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return fwRules, diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func firewallRuleDataSourceLogIdentifier(state *datasource_firewall_rule.FirewallRuleModel) string {
@@ -8,11 +8,12 @@ import (
 )
 
 func TestAccFirewallRuleDataSource(t *testing.T) {
+	resName := GetRandomResourceName(t, "fw")
 	resourceConfig := fmt.Sprintf(`
     resource "ubicloud_firewall" "testacc" {
       project_id  = "%s"
       location    = "%s"
-      name        = "tf-testacc"
+      name        = "%s"
       description = "Terraform acceptance testing"
     }
 
@@ -31,7 +32,7 @@ func TestAccFirewallRuleDataSource(t *testing.T) {
       cidr          = "0.0.0.0/0"
       port_range    = "22..22"
     }			
-    `, GetTestAccProjectId(), GetTestAccLocation())
+    `, GetTestAccProjectId(), GetTestAccLocation(), resName)
 
 	dataConfig := `
     data "ubicloud_firewall_rule" "testaccfwr1" {
@@ -56,8 +57,9 @@ func TestAccFirewallRuleDataSource(t *testing.T) {
     `
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
 		PreCheck:                 func() { TestAccPreCheck(t) },
+		CheckDestroy:             resource.ComposeAggregateTestCheckFunc(testAccCheckFirewallRuleDestroy(t), testAccCheckFirewallDestroy(t)),
 		Steps: []resource.TestStep{
 			{
 				Config: providerConfig + resourceConfig,
@@ -68,7 +70,7 @@ func TestAccFirewallRuleDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ubicloud_firewall.testacc", "id"),
 					resource.TestCheckResourceAttr("data.ubicloud_firewall.testacc", "project_id", GetTestAccProjectId()),
 					resource.TestCheckResourceAttr("data.ubicloud_firewall.testacc", "location", GetTestAccLocation()),
-					resource.TestCheckResourceAttr("data.ubicloud_firewall.testacc", "name", "tf-testacc"),
+					resource.TestCheckResourceAttr("data.ubicloud_firewall.testacc", "name", resName),
 					resource.TestCheckResourceAttr("data.ubicloud_firewall.testacc", "description", "Terraform acceptance testing"),
 					resource.TestCheckResourceAttr("data.ubicloud_firewall.testacc", "firewall_rules.#", "2"),
 
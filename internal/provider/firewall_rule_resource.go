@@ -4,64 +4,55 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/resource_firewall_rule"
-	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_client"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-)
-
-var (
-	_ resource.Resource                = &firewallRuleResource{}
-	_ resource.ResourceWithConfigure   = &firewallRuleResource{}
-	_ resource.ResourceWithImportState = &firewallRuleResource{}
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func NewFirewallRuleResource() resource.Resource {
-	return &firewallRuleResource{}
-}
-
-type firewallRuleResource struct {
-	uc *UbicloudClient
-}
-
-func (r *firewallRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	uc, ok := req.ProviderData.(UbicloudClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *UbicloudClient, got: %T. Please report this issue to support@ubicloud.com.", req.ProviderData),
-		)
-
-		return
+	return &crudResource[firewallRuleResourceModel, ubicloud_client.FirewallRule]{
+		typeName:         "firewall_rule",
+		noun:             "firewall rule",
+		schema:           firewallRuleResourceSchema,
+		importAttributes: []string{"project_id", "location", "firewall_name", "id"},
+		identifier:       firewallRuleResourceLogIdentifier,
+		create:           createFirewallRuleResource,
+		read:             readFirewallRuleResource,
+		delete:           deleteFirewallRuleResource,
+		setState:         setFirewallRuleStateResource,
 	}
-
-	r.uc = &uc
 }
 
-func (r *firewallRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_firewall_rule"
+// firewallRuleResourceModel mirrors the generated FirewallRuleModel, with
+// custom types for cidr and port_range so that equivalent representations
+// returned by the API do not show up as a diff.
+type firewallRuleResourceModel struct {
+	Cidr         cidrValue      `tfsdk:"cidr"`
+	FirewallName types.String   `tfsdk:"firewall_name"`
+	Id           types.String   `tfsdk:"id"`
+	Location     types.String   `tfsdk:"location"`
+	PortRange    portRangeValue `tfsdk:"port_range"`
+	ProjectId    types.String   `tfsdk:"project_id"`
 }
 
-func (r *firewallRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_firewall_rule.FirewallRuleResourceSchema(ctx)
-	resp.Schema.Description = "Provides a Ubicloud FirewallRule resource. This can be used to create and delete firewall rules."
-}
+func firewallRuleResourceSchema(ctx context.Context) schema.Schema {
+	s := resource_firewall_rule.FirewallRuleResourceSchema(ctx)
+	s.Description = "Provides a Ubicloud FirewallRule resource. This can be used to create and delete firewall rules."
 
-func (r *firewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var state resource_firewall_rule.FirewallRuleModel
+	setStringCustomType(&s, "cidr", cidrType{})
+	setStringCustomType(&s, "port_range", portRangeType{})
+	addStringValidators(&s, "cidr", cidrValidator{})
+	addStringValidators(&s, "port_range", portRangeValidator{})
+	return s
+}
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+func createFirewallRuleResource(ctx context.Context, uc *UbicloudClient, state *firewallRuleResourceModel) (*ubicloud_client.FirewallRule, error) {
 	body := ubicloud_client.CreateFirewallRuleJSONRequestBody{
 		Cidr: state.Cidr.ValueString(),
 	}
@@ -69,117 +60,45 @@ func (r *firewallRuleResource) Create(ctx context.Context, req resource.CreateRe
 		body.PortRange = state.PortRange.ValueStringPointer()
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Creating firewall rule: project_id=%s, location=%s, firewall_name: %s", state.ProjectId.ValueString(), state.Location.ValueString(), state.FirewallName.ValueString()))
-	firewallRuleResp, err := r.uc.client.CreateFirewallRuleWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.FirewallName.ValueString(), body)
+	unlock, err := uc.lockFirewall(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.FirewallName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error creating firewall rule: project_id=%s, location=%s, firewall_name: %s", state.ProjectId.ValueString(), state.Location.ValueString(), state.FirewallName.ValueString()),
-			err.Error(),
-		)
-		return
-	}
-
-	if firewallRuleResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code creating firewall rule",
-			fmt.Sprintf("Received %s creating new firewall rule: project_id=%s, location=%s, firewall_name=%s. Details: %s", firewallRuleResp.Status(), state.ProjectId.ValueString(), state.Location.ValueString(), state.FirewallName.ValueString(), firewallRuleResp.Body))
-		return
-	}
-
-	assignStr(firewallRuleResp.JSON200.Id, &state.Id)
-	assignStr(firewallRuleResp.JSON200.Cidr, &state.Cidr)
-	assignStr(firewallRuleResp.JSON200.PortRange, &state.PortRange)
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-}
-
-func (r *firewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_firewall_rule.FirewallRuleModel
-
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
+		return nil, err
 	}
+	defer unlock()
 
-	tflog.Debug(ctx, fmt.Sprintf("Reading firewall rule: %s", firewallRuleResourceLogIdentifier(&state)))
-	firewallRuleResp, err := r.uc.client.GetFirewallRuleDetailsWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.FirewallName.ValueString(), state.Id.ValueString())
+	firewallRuleResp, err := uc.client.CreateFirewallRuleWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.FirewallName.ValueString(), body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error reading firewall rule: %s", firewallRuleResourceLogIdentifier(&state)),
-			err.Error(),
-		)
-		return
+		return nil, err
 	}
-
-	if firewallRuleResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading firewall rule",
-			fmt.Sprintf("Received %s for firewall rule: %s. Details: %s", firewallRuleResp.Status(), firewallRuleResourceLogIdentifier(&state), firewallRuleResp.Body))
-		return
-	}
-
-	assignStr(firewallRuleResp.JSON200.Id, &state.Id)
-	assignStr(firewallRuleResp.JSON200.Cidr, &state.Cidr)
-	assignStr(firewallRuleResp.JSON200.PortRange, &state.PortRange)
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	return firewallRuleResp.JSON200, ubicloud.CheckResponse(firewallRuleResp, firewallRuleResp.Body, http.StatusOK)
 }
 
-func (r *firewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state resource_firewall_rule.FirewallRuleModel
-
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
+func readFirewallRuleResource(ctx context.Context, uc *UbicloudClient, state *firewallRuleResourceModel) (*ubicloud_client.FirewallRule, error) {
+	firewallRuleResp, err := uc.client.GetFirewallRuleDetailsWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.FirewallName.ValueString(), state.Id.ValueString())
+	if err != nil {
+		return nil, err
 	}
-
-	resp.Diagnostics.AddError(
-		"Update of firewall rule is not supported",
-		fmt.Sprintf("Cannot update firewall rule: %s", firewallRuleResourceLogIdentifier(&state)))
+	return firewallRuleResp.JSON200, ubicloud.CheckResponse(firewallRuleResp, firewallRuleResp.Body, http.StatusOK)
 }
 
-func (r *firewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_firewall_rule.FirewallRuleModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	tflog.Debug(ctx, fmt.Sprintf("Deleting firewall rule: %s", firewallRuleResourceLogIdentifier(&state)))
-	firewallRuleResp, err := r.uc.client.DeleteFirewallRuleWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.FirewallName.ValueString(), state.Id.ValueString())
+func deleteFirewallRuleResource(ctx context.Context, uc *UbicloudClient, state *firewallRuleResourceModel) error {
+	unlock, err := uc.lockFirewall(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.FirewallName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error deleting firewall rule: %s", firewallRuleResourceLogIdentifier(&state)),
-			err.Error(),
-		)
-		return
+		return err
 	}
+	defer unlock()
 
-	if firewallRuleResp.StatusCode() != http.StatusNoContent && firewallRuleResp.StatusCode() != http.StatusNotFound {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code deleting firewallRule",
-			fmt.Sprintf("Received %s deleting firewall rule: %s. Details: %s", firewallRuleResp.Status(), fmt.Sprintf("Deleting firewall rule: %s", firewallRuleResourceLogIdentifier(&state)), firewallRuleResp.Body))
-		return
+	firewallRuleResp, err := uc.client.DeleteFirewallRuleWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.FirewallName.ValueString(), state.Id.ValueString())
+	if err != nil {
+		return err
 	}
+	return ubicloud.CheckResponse(firewallRuleResp, firewallRuleResp.Body, http.StatusNoContent)
 }
 
-func (r *firewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: project_id,location,firewall_name,id. Got: %q", req.ID),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("location"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("firewall_name"), idParts[2])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[3])...)
+func setFirewallRuleStateResource(ctx context.Context, firewallRule *ubicloud_client.FirewallRule, state *firewallRuleResourceModel) diag.Diagnostics {
+	return mapState(ctx, firewallRule, state)
 }
 
-func firewallRuleResourceLogIdentifier(state *resource_firewall_rule.FirewallRuleModel) string {
+func firewallRuleResourceLogIdentifier(state *firewallRuleResourceModel) string {
 	return fmt.Sprintf("project_id=%s, location=%s, firewall_name=%s, rule_id=%s", state.ProjectId.ValueString(), state.Location.ValueString(), state.FirewallName.ValueString(), state.Id.ValueString())
 }
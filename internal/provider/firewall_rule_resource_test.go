@@ -1,19 +1,24 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
 )
 
 func TestAccFirewallRuleResource(t *testing.T) {
-	resourceConfig := fmt.Sprintf(`
+	resName := GetRandomResourceName(t, "fw")
+	resourceConfig := func(portRange string) string {
+		return fmt.Sprintf(`
     resource "ubicloud_firewall" "testacc" {
       project_id  = "%s"
       location    = "%s"
-      name        = "tf-testacc"
+      name        = "%s"
       description = "Terraform acceptance testing"
     }
 
@@ -22,25 +27,27 @@ func TestAccFirewallRuleResource(t *testing.T) {
       location    = ubicloud_firewall.testacc.location
       firewall_name = ubicloud_firewall.testacc.name
       cidr        = "0.0.0.0/0"
-      port_range  = "22..22"
-    }			
-    `, GetTestAccProjectId(), GetTestAccLocation())
+      port_range  = "%s"
+    }
+    `, GetTestAccProjectId(), GetTestAccLocation(), resName, portRange)
+	}
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
 		PreCheck:                 func() { TestAccPreCheck(t) },
+		CheckDestroy:             resource.ComposeAggregateTestCheckFunc(testAccCheckFirewallRuleDestroy(t), testAccCheckFirewallDestroy(t)),
 		Steps: []resource.TestStep{
 			// Test Create and Read
 			{
-				Config: providerConfig + resourceConfig,
+				Config: providerConfig + resourceConfig("22..22"),
 			},
 			{
-				Config: providerConfig + resourceConfig,
+				Config: providerConfig + resourceConfig("22..22"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("ubicloud_firewall.testacc", "id"),
 					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "project_id", GetTestAccProjectId()),
 					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "location", GetTestAccLocation()),
-					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "name", "tf-testacc"),
+					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "name", resName),
 					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "description", "Terraform acceptance testing"),
 					resource.TestCheckResourceAttr("ubicloud_firewall.testacc", "firewall_rules.#", "1"),
 
@@ -58,6 +65,18 @@ func TestAccFirewallRuleResource(t *testing.T) {
 				ImportStateIdFunc: importStateIdFunc("ubicloud_firewall_rule.testaccfwr1"),
 				ImportStateVerify: true,
 			},
+			// Test that changing a rule replaces it
+			{
+				Config: providerConfig + resourceConfig("443..443"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ubicloud_firewall_rule.testaccfwr1", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ubicloud_firewall_rule.testaccfwr1", "port_range", "443..443"),
+				),
+			},
 		},
 	})
 }
@@ -76,3 +95,13 @@ func importStateIdFunc(fwr string) resource.ImportStateIdFunc {
 		return fmt.Sprintf("%s,%s,%s,%s", GetTestAccProjectId(), rs.Primary.Attributes["location"], rs.Primary.Attributes["firewall_name"], rs.Primary.ID), nil
 	}
 }
+
+func testAccCheckFirewallRuleDestroy(t *testing.T) resource.TestCheckFunc {
+	return testAccCheckDestroy(t, "ubicloud_firewall_rule", func(ctx context.Context, client *ubicloud.Client, attrs map[string]string) (bool, error) {
+		resp, err := client.GetFirewallRuleDetailsWithResponse(ctx, attrs["project_id"], attrs["location"], attrs["firewall_name"], attrs["id"])
+		if err != nil {
+			return false, err
+		}
+		return testAccExists(resp.StatusCode(), resp.Body)
+	})
+}
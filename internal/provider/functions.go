@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// parsePrefixArgument parses a CIDR argument of a function. Host bits are
+// allowed, as in the built-in cidr functions of Terraform, and are cleared.
+func parsePrefixArgument(argument int64, value string) (netip.Prefix, *function.FuncError) {
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return netip.Prefix{}, function.NewArgumentFuncError(argument, fmt.Sprintf("Invalid CIDR %q: %s", value, err.Error()))
+	}
+	return prefix.Masked(), nil
+}
+
+// parseAddressOrPrefixArgument parses an argument of a function that is
+// either an IP address or a CIDR. An address is returned as a single host
+// prefix.
+func parseAddressOrPrefixArgument(argument int64, value string) (netip.Prefix, *function.FuncError) {
+	if addr, err := netip.ParseAddr(value); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return netip.Prefix{}, function.NewArgumentFuncError(argument, fmt.Sprintf("Invalid IP address or CIDR %q", value))
+	}
+	return prefix.Masked(), nil
+}
+
+// prefixHost returns the address with number hostnum in prefix. Negative
+// numbers count back from the last address of the prefix. The arithmetic is
+// done on big integers, so that it also works for IPv6 prefixes larger than
+// 64 bits.
+func prefixHost(prefix netip.Prefix, hostnum int64) (netip.Addr, error) {
+	prefix = prefix.Masked()
+	hostBits := uint(prefix.Addr().BitLen() - prefix.Bits())
+	size := new(big.Int).Lsh(big.NewInt(1), hostBits)
+
+	host := big.NewInt(hostnum)
+	if hostnum < 0 {
+		host.Add(host, size)
+	}
+	if host.Sign() < 0 || host.Cmp(size) >= 0 {
+		return netip.Addr{}, fmt.Errorf("host number %d is out of range for %s, which has %s addresses", hostnum, prefix, size)
+	}
+
+	base := prefix.Addr().AsSlice()
+	sum := new(big.Int).Add(new(big.Int).SetBytes(base), host)
+	addr, _ := netip.AddrFromSlice(sum.FillBytes(make([]byte, len(base))))
+	return addr, nil
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type functionTestCase struct {
+	arguments []attr.Value
+	expected  attr.Value
+	error     bool
+}
+
+// runFunctionTests runs a function without Terraform, which only supports
+// provider functions starting with version 1.8.
+func runFunctionTests(t *testing.T, f function.Function, tests map[string]functionTestCase) {
+	t.Helper()
+
+	ctx := context.Background()
+	definitionResp := &function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, definitionResp)
+	if definitionResp.Diagnostics.HasError() {
+		t.Fatalf("invalid definition: %v", definitionResp.Diagnostics)
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, funcErr := definitionResp.Definition.Return.NewResultData(ctx)
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr)
+			}
+			resp := &function.RunResponse{Result: result}
+			f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(test.arguments)}, resp)
+
+			if test.error {
+				if resp.Error == nil {
+					t.Errorf("expected error, got %v", resp.Result.Value())
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if !resp.Result.Value().Equal(test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, resp.Result.Value())
+			}
+		})
+	}
+}
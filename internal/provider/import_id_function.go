@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &importIdFunction{}
+
+func NewImportIdFunction() function.Function {
+	return &importIdFunction{}
+}
+
+type importIdFunction struct{}
+
+func (f *importIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "import_id"
+}
+
+func (f *importIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the identifier to import a resource",
+		MarkdownDescription: "Returns the comma-separated identifier expected by the `import` block of a resource, made of the id of its project followed by the other parts of the identifier:\n\n" +
+			"- `ubicloud_vm`, `ubicloud_postgres`, `ubicloud_private_subnet` and `ubicloud_firewall`: `location` and `name`.\n" +
+			"- `ubicloud_firewall_rule`: `location`, the name of the firewall and the id of the rule.\n" +
+			"- `ubicloud_postgres_firewall_rule`: `location`, the name of the Postgres database and the id of the rule.\n" +
+			"- `ubicloud_firewall_attachment`: `location`, the name of the firewall and the id of the private subnet.\n" +
+			"- `ubicloud_ssh_public_key`: the id of the key.\n" +
+			"- `ubicloud_project`: no other part, the project is imported by its id.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "project_id",
+				MarkdownDescription: "ID of the project.",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "parts",
+			MarkdownDescription: "Other parts of the identifier, such as the location and name of the resource.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *importIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var projectId string
+	var others []string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &projectId, &others))
+	if resp.Error != nil {
+		return
+	}
+
+	parts := append([]string{projectId}, others...)
+	for i, part := range parts {
+		if part == "" {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), "Import identifier parts must not be empty"))
+		} else if strings.Contains(part, ",") {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), fmt.Sprintf("Import identifier part %q must not contain a comma", part)))
+		}
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.Join(parts, ",")))
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestImportIdFunction(t *testing.T) {
+	args := func(parts ...string) []attr.Value {
+		values := []attr.Value{}
+		for _, part := range parts[:1] {
+			values = append(values, types.StringValue(part))
+		}
+		others := []attr.Value{}
+		elemTypes := []attr.Type{}
+		for _, part := range parts[1:] {
+			others = append(others, types.StringValue(part))
+			elemTypes = append(elemTypes, types.StringType)
+		}
+		return append(values, types.TupleValueMust(elemTypes, others))
+	}
+
+	runFunctionTests(t, NewImportIdFunction(), map[string]functionTestCase{
+		"vm":             {arguments: args("pj01qy4sty1j7nycv8hfqmgy6t", "eu-central-h1", "my-vm"), expected: types.StringValue("pj01qy4sty1j7nycv8hfqmgy6t,eu-central-h1,my-vm")},
+		"firewall rule":  {arguments: args("pj01qy4sty1j7nycv8hfqmgy6t", "eu-central-h1", "my-fw", "fr9wf3tra2r23nvsrcm5jkxb8d"), expected: types.StringValue("pj01qy4sty1j7nycv8hfqmgy6t,eu-central-h1,my-fw,fr9wf3tra2r23nvsrcm5jkxb8d")},
+		"project":        {arguments: args("pj01qy4sty1j7nycv8hfqmgy6t"), expected: types.StringValue("pj01qy4sty1j7nycv8hfqmgy6t")},
+		"ssh public key": {arguments: args("pj01qy4sty1j7nycv8hfqmgy6t", "sk01qy4sty1j7nycv8hfqmgy6t"), expected: types.StringValue("pj01qy4sty1j7nycv8hfqmgy6t,sk01qy4sty1j7nycv8hfqmgy6t")},
+		"empty name":     {arguments: args("pj01qy4sty1j7nycv8hfqmgy6t", "eu-central-h1", ""), error: true},
+		"empty id":       {arguments: args("pj01qy4sty1j7nycv8hfqmgy6t", "eu-central-h1", "my-fw", ""), error: true},
+		"comma":          {arguments: args("pj01qy4sty1j7nycv8hfqmgy6t", "eu-central-h1,x", "my-vm"), error: true},
+	})
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ipv6InterfaceIdFunction{}
+
+func NewIpv6InterfaceIdFunction() function.Function {
+	return &ipv6InterfaceIdFunction{}
+}
+
+type ipv6InterfaceIdFunction struct{}
+
+func (f *ipv6InterfaceIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ipv6_interface_id"
+}
+
+func (f *ipv6InterfaceIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Extracts the interface identifier of an IPv6 address",
+		MarkdownDescription: "Returns the interface identifier of an IPv6 address, which are its low 64 bits, as an IPv6 address with the network bits cleared, e.g. `::1a2b:3c4d:5e6f:7081`. " +
+			"A prefix length in `address` is ignored, so the `ip6` of a VM can be passed as is.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "address",
+				MarkdownDescription: "IPv6 address, optionally with a prefix length.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ipv6InterfaceIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var address string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &address))
+	if resp.Error != nil {
+		return
+	}
+
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		prefix, prefixErr := netip.ParsePrefix(address)
+		if prefixErr != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("Invalid IPv6 address %q", address)))
+			return
+		}
+		addr = prefix.Addr()
+	}
+
+	if !addr.Is6() || addr.Is4In6() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("%q is not an IPv6 address", address)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, ipv6InterfaceId(addr).String()))
+}
+
+// ipv6InterfaceId returns addr with its 64 network bits cleared. The zone of
+// addr is dropped.
+func ipv6InterfaceId(addr netip.Addr) netip.Addr {
+	bytes := addr.As16()
+	clear(bytes[:8])
+	return netip.AddrFrom16(bytes)
+}
@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIpv6InterfaceIdFunction(t *testing.T) {
+	args := func(address string) []attr.Value {
+		return []attr.Value{types.StringValue(address)}
+	}
+
+	runFunctionTests(t, NewIpv6InterfaceIdFunction(), map[string]functionTestCase{
+		"address":         {arguments: args("2a01:4f8:10a:128b:1a2b:3c4d:5e6f:7081"), expected: types.StringValue("::1a2b:3c4d:5e6f:7081")},
+		"short address":   {arguments: args("fd10:9b0b:6b4b:8fbb::2"), expected: types.StringValue("::2")},
+		"with prefix":     {arguments: args("2a01:4f8:10a:128b:4919::/79"), expected: types.StringValue("::4919:0:0:0")},
+		"with zone":       {arguments: args("fe80::1%eth0"), expected: types.StringValue("::1")},
+		"network address": {arguments: args("2a01:4f8:10a:128b::"), expected: types.StringValue("::")},
+		"ipv4":            {arguments: args("10.0.0.1"), error: true},
+		"ipv4 mapped":     {arguments: args("::ffff:10.0.0.1"), error: true},
+		"invalid":         {arguments: args("2a01:4f8::10a::1"), error: true},
+	})
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// mapState sets the attributes of state, a pointer to a Terraform model, from
+// object, a pointer to a struct of the API client, matching the tfsdk tags of
+// the model to the json tags of the client. Nested objects and lists of
+// objects are converted to the types state holds, which lists take from their
+// element type, as set when the model is read from a plan, state or
+// configuration.
+//
+// Fields the API did not return leave the attribute as it is, except lists,
+// which are set empty. Attributes the API does not know about, like the ones
+// only used to create the object, are left untouched too.
+func mapState(ctx context.Context, object any, state any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	source := reflect.ValueOf(object).Elem()
+	sourceFields := jsonFields(source.Type())
+	target := reflect.ValueOf(state).Elem()
+
+	for i := range target.NumField() {
+		name := target.Type().Field(i).Tag.Get("tfsdk")
+		index, ok := sourceFields[name]
+		if !ok {
+			continue
+		}
+
+		field := source.Field(index)
+		if field.Kind() == reflect.Pointer && field.IsNil() && field.Type().Elem().Kind() != reflect.Slice {
+			continue
+		}
+
+		current, ok := target.Field(i).Interface().(attr.Value)
+		if !ok {
+			diags.AddAttributeError(path.Root(name), "Error Mapping API Response", fmt.Sprintf("%s is not a Terraform value.", target.Type().Field(i).Type))
+			continue
+		}
+
+		value, valueDiags := mapValue(ctx, field, current.Type(ctx), path.Root(name))
+		diags.Append(valueDiags...)
+		if valueDiags.HasError() {
+			continue
+		}
+
+		if !reflect.TypeOf(value).AssignableTo(target.Field(i).Type()) {
+			diags.AddAttributeError(path.Root(name), "Error Mapping API Response", fmt.Sprintf("Cannot assign %T to %s.", value, target.Field(i).Type()))
+			continue
+		}
+		target.Field(i).Set(reflect.ValueOf(value))
+	}
+
+	return diags
+}
+
+// mapValue converts the value of a field of the API client to a value of
+// type t.
+func mapValue(ctx context.Context, v reflect.Value, t attr.Type, p path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			if listType, ok := t.(basetypes.ListType); ok && listType.ElemType != nil {
+				return types.ListValueMust(listType.ElemType, []attr.Value{}), nil
+			}
+			return nullValue(ctx, t, p)
+		}
+		v = v.Elem()
+	}
+
+	mismatch := func() (attr.Value, diag.Diagnostics) {
+		diags.AddAttributeError(p, "Error Mapping API Response", fmt.Sprintf("Cannot convert %s to %s.", v.Type(), t))
+		return nil, diags
+	}
+
+	switch t := t.(type) {
+	case basetypes.ListType:
+		if t.ElemType == nil {
+			diags.AddAttributeError(p, "Error Mapping API Response", "The element type of the list is unknown.")
+			return nil, diags
+		}
+		if v.Kind() != reflect.Slice {
+			return mismatch()
+		}
+
+		elements := make([]attr.Value, 0, v.Len())
+		for i := range v.Len() {
+			element, elementDiags := mapValue(ctx, v.Index(i), t.ElemType, p.AtListIndex(i))
+			diags.Append(elementDiags...)
+			if elementDiags.HasError() {
+				return nil, diags
+			}
+			elements = append(elements, element)
+		}
+
+		list, listDiags := types.ListValue(t.ElemType, elements)
+		diags.Append(listDiags...)
+		return list, diags
+
+	case basetypes.ObjectTypable:
+		attrTypes := objectAttributeTypes(t)
+		if attrTypes == nil || v.Kind() != reflect.Struct {
+			return mismatch()
+		}
+
+		fields := jsonFields(v.Type())
+		attributes := make(map[string]attr.Value, len(attrTypes))
+		for name, attrType := range attrTypes {
+			var (
+				value      attr.Value
+				valueDiags diag.Diagnostics
+			)
+			if index, ok := fields[name]; ok {
+				value, valueDiags = mapValue(ctx, v.Field(index), attrType, p.AtName(name))
+			} else {
+				value, valueDiags = nullValue(ctx, attrType, p.AtName(name))
+			}
+			diags.Append(valueDiags...)
+			if valueDiags.HasError() {
+				return nil, diags
+			}
+			attributes[name] = value
+		}
+
+		object, objectDiags := types.ObjectValue(attrTypes, attributes)
+		diags.Append(objectDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		value, valueDiags := t.ValueFromObject(ctx, object)
+		diags.Append(valueDiags...)
+		return value, diags
+
+	case basetypes.StringTypable:
+		if v.Kind() != reflect.String {
+			return mismatch()
+		}
+		value, valueDiags := t.ValueFromString(ctx, types.StringValue(v.String()))
+		diags.Append(valueDiags...)
+		return value, diags
+
+	case basetypes.Int64Typable:
+		if !v.CanInt() {
+			return mismatch()
+		}
+		value, valueDiags := t.ValueFromInt64(ctx, types.Int64Value(v.Int()))
+		diags.Append(valueDiags...)
+		return value, diags
+
+	case basetypes.Float64Typable:
+		if !v.CanFloat() {
+			return mismatch()
+		}
+		value, valueDiags := t.ValueFromFloat64(ctx, types.Float64Value(v.Float()))
+		diags.Append(valueDiags...)
+		return value, diags
+
+	case basetypes.BoolTypable:
+		if v.Kind() != reflect.Bool {
+			return mismatch()
+		}
+		value, valueDiags := t.ValueFromBool(ctx, types.BoolValue(v.Bool()))
+		diags.Append(valueDiags...)
+		return value, diags
+	}
+
+	return mismatch()
+}
+
+// nullValue returns the null value of type t.
+func nullValue(ctx context.Context, t attr.Type, p path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value, err := t.ValueFromTerraform(ctx, tftypes.NewValue(t.TerraformType(ctx), nil))
+	if err != nil {
+		diags.AddAttributeError(p, "Error Mapping API Response", fmt.Sprintf("Cannot create a null %s: %s.", t, err))
+		return nil, diags
+	}
+	return value, diags
+}
+
+// objectAttributeTypes returns the attribute types of an object type, or nil
+// if it is not an object type. The generated object types embed
+// basetypes.ObjectType, and so expose them.
+func objectAttributeTypes(t attr.Type) map[string]attr.Type {
+	if t, ok := t.(attr.TypeWithAttributeTypes); ok {
+		return t.AttributeTypes()
+	}
+	return nil
+}
+
+// jsonFields returns the indexes of the fields of a struct of the API client
+// by the names of their json tags.
+func jsonFields(t reflect.Type) map[string]int {
+	fields := map[string]int{}
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields[name] = i
+		}
+	}
+	return fields
+}
+
+// unmappedFields returns the json names of the fields of the API client type
+// object, including the ones of nested objects as parent.child, for which t,
+// the type of a Terraform schema, has no attribute. mapState ignores them.
+func unmappedFields(object reflect.Type, t attr.Type) []string {
+	for object.Kind() == reflect.Pointer || object.Kind() == reflect.Slice {
+		object = object.Elem()
+	}
+	if listType, ok := t.(basetypes.ListType); ok {
+		t = listType.ElemType
+	}
+
+	attrTypes := objectAttributeTypes(t)
+	if object.Kind() != reflect.Struct || attrTypes == nil {
+		return nil
+	}
+
+	var unmapped []string
+	for name, index := range jsonFields(object) {
+		attrType, ok := attrTypes[name]
+		if !ok {
+			unmapped = append(unmapped, name)
+			continue
+		}
+		for _, nested := range unmappedFields(object.Field(index).Type, attrType) {
+			unmapped = append(unmapped, name+"."+nested)
+		}
+	}
+
+	slices.Sort(unmapped)
+	return unmapped
+}
@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/datasource_firewall"
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/datasource_firewall_rule"
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/datasource_postgres"
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/datasource_private_subnet"
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/datasource_project"
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/datasource_vm"
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/resource_project"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMapState(t *testing.T) {
+	ctx := context.Background()
+	firewallsType := datasource_vm.FirewallsValue{}.Type(ctx)
+
+	vm := ubicloud_client.VmDetailed{
+		Id:             ptr("vm1"),
+		Ip4:            ptr("192.0.2.1"),
+		Ip6:            ptr("2001:db8::2"),
+		Size:           ptr("standard-2"),
+		StorageSizeGib: ptr(40),
+		Firewalls: &[]ubicloud_client.Firewall{{
+			Id:            ptr("fw1"),
+			Name:          ptr("default"),
+			FirewallRules: &[]ubicloud_client.FirewallRule{{Id: ptr("fr1"), Cidr: ptr("0.0.0.0/0")}},
+		}},
+	}
+	state := datasource_vm.VmModel{
+		Firewalls: types.ListNull(firewallsType),
+		Name:      types.StringValue("name"),
+		ProjectId: types.StringValue("pj1"),
+	}
+	if diags := mapState(ctx, &vm, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	for name, test := range map[string]struct {
+		got      attr.Value
+		expected attr.Value
+	}{
+		"id":                 {state.Id, types.StringValue("vm1")},
+		"ip4":                {state.Ip4, types.StringValue("192.0.2.1")},
+		"ip6":                {state.Ip6, types.StringValue("2001:db8::2")},
+		"storage_size_gib":   {state.StorageSizeGib, types.Int64Value(40)},
+		"name not returned":  {state.Name, types.StringValue("name")},
+		"project_id":         {state.ProjectId, types.StringValue("pj1")},
+		"state not returned": {state.State, types.StringNull()},
+	} {
+		if !test.got.Equal(test.expected) {
+			t.Errorf("%s: expected %s, got %s", name, test.expected, test.got)
+		}
+	}
+
+	var firewalls []datasource_vm.FirewallsValue
+	if diags := state.Firewalls.ElementsAs(ctx, &firewalls, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(firewalls) != 1 || firewalls[0].Id.ValueString() != "fw1" || !firewalls[0].Description.IsNull() {
+		t.Fatalf("unexpected firewalls: %s", state.Firewalls)
+	}
+	var rules []datasource_vm.FirewallRulesValue
+	if diags := firewalls[0].FirewallRules.ElementsAs(ctx, &rules, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(rules) != 1 || rules[0].Cidr.ValueString() != "0.0.0.0/0" || !rules[0].PortRange.IsNull() {
+		t.Errorf("unexpected firewall rules: %s", firewalls[0].FirewallRules)
+	}
+}
+
+func TestMapStateEmptyList(t *testing.T) {
+	ctx := context.Background()
+	state := datasource_vm.VmModel{Firewalls: types.ListUnknown(datasource_vm.FirewallsValue{}.Type(ctx))}
+
+	if diags := mapState(ctx, &ubicloud_client.VmDetailed{}, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if state.Firewalls.IsUnknown() || state.Firewalls.IsNull() || len(state.Firewalls.Elements()) != 0 {
+		t.Errorf("expected an empty list, got %s", state.Firewalls)
+	}
+}
+
+func TestMapStateCustomTypes(t *testing.T) {
+	state := firewallRuleResourceModel{}
+	rule := ubicloud_client.FirewallRule{Id: ptr("fr1"), Cidr: ptr("10.0.0.0/8"), PortRange: ptr("22..22")}
+
+	if diags := mapState(context.Background(), &rule, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if state.Cidr.ValueString() != "10.0.0.0/8" || state.PortRange.ValueString() != "22..22" {
+		t.Errorf("unexpected state: %+v", state)
+	}
+}
+
+func TestMapStateNumbers(t *testing.T) {
+	state := datasource_project.ProjectModel{}
+	project := ubicloud_client.Project{Credit: ptr(float32(12.5)), Discount: ptr(10)}
+
+	if diags := mapState(context.Background(), &project, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if state.Credit.ValueFloat64() != 12.5 || state.Discount.ValueInt64() != 10 {
+		t.Errorf("unexpected state: %+v", state)
+	}
+}
+
+// TestMapStateNestedTypeOfModel checks that nested objects take the type of
+// the model, whatever other schemas declare for the same API object.
+func TestMapStateNestedTypeOfModel(t *testing.T) {
+	ctx := context.Background()
+	firewallsType := types.ObjectType{AttrTypes: map[string]attr.Type{"id": types.StringType}}
+	state := struct {
+		Firewalls types.List `tfsdk:"firewalls"`
+	}{Firewalls: types.ListNull(firewallsType)}
+	vm := ubicloud_client.VmDetailed{Firewalls: &[]ubicloud_client.Firewall{{Id: ptr("fw1"), Name: ptr("default")}}}
+
+	if diags := mapState(ctx, &vm, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	expected := types.ListValueMust(firewallsType, []attr.Value{
+		types.ObjectValueMust(firewallsType.AttrTypes, map[string]attr.Value{"id": types.StringValue("fw1")}),
+	})
+	if !state.Firewalls.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, state.Firewalls)
+	}
+}
+
+func TestMapStateUnknownElementType(t *testing.T) {
+	state := datasource_vm.VmModel{}
+	vm := ubicloud_client.VmDetailed{Firewalls: &[]ubicloud_client.Firewall{}}
+
+	diags := mapState(context.Background(), &vm, &state)
+	if errs := diags.Errors(); len(errs) != 1 || errs[0].Summary() != "Error Mapping API Response" {
+		t.Errorf("expected a mapping error, got %v", diags)
+	}
+}
+
+// TestUnmappedFields lists the fields of the API the schemas leave out, so
+// that new fields of the API are noticed.
+func TestUnmappedFields(t *testing.T) {
+	ctx := context.Background()
+	tests := map[string]struct {
+		object   any
+		schema   attr.Type
+		expected []string
+	}{
+		// state is ignored by the generator, see config/tf_generator_config.yml.
+		"vm resource":    {ubicloud_client.VmDetailed{}, vmResourceSchema(ctx).Type(), []string{"state"}},
+		"vm data source": {ubicloud_client.VmDetailed{}, datasource_vm.VmDataSourceSchema(ctx).Type(), nil},
+		"postgres resource": {ubicloud_client.PostgresDetailed{}, postgresResourceSchema(ctx).Type(), []string{
+			"connection_string", "earliest_restore_time", "latest_restore_time", "state",
+		}},
+		"postgres data source":            {ubicloud_client.PostgresDetailed{}, datasource_postgres.PostgresDataSourceSchema(ctx).Type(), nil},
+		"postgres firewall rule resource": {ubicloud_client.PostgresFirewallRule{}, postgresFirewallRuleResourceSchema(ctx).Type(), nil},
+		"private subnet resource":         {ubicloud_client.PrivateSubnet{}, privateSubnetResourceSchema(ctx).Type(), nil},
+		"private subnet data source":      {ubicloud_client.PrivateSubnet{}, datasource_private_subnet.PrivateSubnetDataSourceSchema(ctx).Type(), nil},
+		"firewall resource":               {ubicloud_client.Firewall{}, firewallResourceSchema(ctx).Type(), nil},
+		"firewall data source":            {ubicloud_client.Firewall{}, datasource_firewall.FirewallDataSourceSchema(ctx).Type(), nil},
+		"firewall rule resource":          {ubicloud_client.FirewallRule{}, firewallRuleResourceSchema(ctx).Type(), nil},
+		"firewall rule data source":       {ubicloud_client.FirewallRule{}, datasource_firewall_rule.FirewallRuleDataSourceSchema(ctx).Type(), nil},
+		"project resource":                {ubicloud_client.Project{}, projectResourceSchema(ctx).Type(), nil},
+		"project data source":             {ubicloud_client.Project{}, datasource_project.ProjectDataSourceSchema(ctx).Type(), nil},
+		"ssh public key resource":         {ubicloud_client.SshPublicKey{}, sshPublicKeyResourceSchema(ctx).Type(), nil},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := unmappedFields(reflect.TypeOf(test.object), test.schema)
+			if !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected unmapped fields %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+var (
+	testFirewall = ubicloud_client.Firewall{
+		Id:            ptr("fw1"),
+		Name:          ptr("default"),
+		Description:   ptr("Default firewall"),
+		Location:      ptr("eu-central-h1"),
+		FirewallRules: &[]ubicloud_client.FirewallRule{{Id: ptr("fr1"), Cidr: ptr("0.0.0.0/0"), PortRange: ptr("22..22")}},
+	}
+	testPostgres = ubicloud_client.PostgresDetailed{
+		Id:            ptr("pg1"),
+		Name:          ptr("db"),
+		VmSize:        ptr("standard-2"),
+		Primary:       ptr(true),
+		FirewallRules: &[]ubicloud_client.PostgresFirewallRule{{Id: ptr("pf1"), Cidr: ptr("10.0.0.0/8")}},
+	}
+)
+
+// TestSetStateMatchesSchema sets the state of each resource and data source
+// from API objects with all nested lists populated, and saves it with the
+// schema of the resource or data source. The nested values must be of the
+// types of that schema, so that the schemas of resources and data sources
+// built from the same API objects can change independently.
+func TestSetStateMatchesSchema(t *testing.T) {
+	ctx := context.Background()
+	vm := ubicloud_client.VmDetailed{Id: ptr("vm1"), Ip4: ptr("192.0.2.1"), Firewalls: &[]ubicloud_client.Firewall{testFirewall}}
+	ps := ubicloud_client.PrivateSubnet{
+		Id:        ptr("ps1"),
+		Firewalls: &[]ubicloud_client.Firewall{testFirewall},
+		Nics:      &[]ubicloud_client.Nic{{Id: ptr("nc1"), VmName: ptr("vm")}},
+	}
+	rule := (*testFirewall.FirewallRules)[0]
+	project := ubicloud_client.Project{Id: ptr("pj1"), Credit: ptr(float32(1)), Discount: ptr(0)}
+	key := ubicloud_client.SshPublicKey{Id: ptr("sk1"), PublicKey: ptr(testAccPublicKey)}
+
+	tests := map[string]func(t *testing.T){
+		"vm resource": func(t *testing.T) {
+			testSetState(t, tfsdk.State{Schema: vmResourceSchema(ctx)}, func(ctx context.Context, state *vmResourceModel) diag.Diagnostics {
+				return setVmStateResource(ctx, &vm, state)
+			})
+		},
+		"vm data source": func(t *testing.T) {
+			testSetState(t, tfsdk.State{Schema: datasource_vm.VmDataSourceSchema(ctx)}, func(ctx context.Context, state *datasource_vm.VmModel) diag.Diagnostics {
+				return setVmStateDatasource(ctx, &vm, state)
+			})
+		},
+		"private subnet resource": func(t *testing.T) {
+			testSetState(t, tfsdk.State{Schema: privateSubnetResourceSchema(ctx)}, func(ctx context.Context, state *privateSubnetResourceModel) diag.Diagnostics {
+				return setPrivateSubnetStateResource(ctx, &ps, state)
+			})
+		},
+		"private subnet data source": func(t *testing.T) {
+			testSetState(t, tfsdk.State{Schema: datasource_private_subnet.PrivateSubnetDataSourceSchema(ctx)}, func(ctx context.Context, state *datasource_private_subnet.PrivateSubnetModel) diag.Diagnostics {
+				return setPrivateSubnetStateDatasource(ctx, &ps, state)
+			})
+		},
+		"postgres resource": func(t *testing.T) {
+			testSetState(t, tfsdk.State{Schema: postgresResourceSchema(ctx)}, func(ctx context.Context, state *postgresResourceModel) diag.Diagnostics {
+				return setPostgresStateResource(ctx, &testPostgres, state)
+			})
+		},
+		"postgres data source": func(t *testing.T) {
+			testSetState(t, tfsdk.State{Schema: datasource_postgres.PostgresDataSourceSchema(ctx)}, func(ctx context.Context, state *datasource_postgres.PostgresModel) diag.Diagnostics {
+				return setPostgresStateDatasource(ctx, &testPostgres, state)
+			})
+		},
+		"postgres firewall rule resource": func(t *testing.T) {
+			testSetState(t, tfsdk.State{Schema: postgresFirewallRuleResourceSchema(ctx)}, func(ctx context.Context, state *postgresFirewallRuleResourceModel) diag.Diagnostics {
+				return setPostgresFirewallRuleStateResource(ctx, &(*testPostgres.FirewallRules)[0], state)
+			})
+		},
+		"firewall resource": func(t *testing.T) {
+			testSetState(t, tfsdk.State{Schema: firewallResourceSchema(ctx)}, func(ctx context.Context, state *firewallResourceModel) diag.Diagnostics {
+				return setFirewallStateResource(ctx, &testFirewall, state)
+			})
+		},
+		"firewall data source": func(t *testing.T) {
+			testSetState(t, tfsdk.State{Schema: datasource_firewall.FirewallDataSourceSchema(ctx)}, func(ctx context.Context, state *datasource_firewall.FirewallModel) diag.Diagnostics {
+				return mapState(ctx, &testFirewall, state)
+			})
+		},
+		"firewall rule resource": func(t *testing.T) {
+			testSetState(t, tfsdk.State{Schema: firewallRuleResourceSchema(ctx)}, func(ctx context.Context, state *firewallRuleResourceModel) diag.Diagnostics {
+				return setFirewallRuleStateResource(ctx, &rule, state)
+			})
+		},
+		"firewall rule data source": func(t *testing.T) {
+			testSetState(t, tfsdk.State{Schema: datasource_firewall_rule.FirewallRuleDataSourceSchema(ctx)}, func(ctx context.Context, state *datasource_firewall_rule.FirewallRuleModel) diag.Diagnostics {
+				return mapState(ctx, &rule, state)
+			})
+		},
+		"project resource": func(t *testing.T) {
+			testSetState(t, tfsdk.State{Schema: projectResourceSchema(ctx)}, func(ctx context.Context, state *resource_project.ProjectModel) diag.Diagnostics {
+				return setProjectStateResource(ctx, &project, state)
+			})
+		},
+		"project data source": func(t *testing.T) {
+			testSetState(t, tfsdk.State{Schema: datasource_project.ProjectDataSourceSchema(ctx)}, func(ctx context.Context, state *datasource_project.ProjectModel) diag.Diagnostics {
+				return mapState(ctx, &project, state)
+			})
+		},
+		"ssh public key resource": func(t *testing.T) {
+			testSetState(t, tfsdk.State{Schema: sshPublicKeyResourceSchema(ctx)}, func(ctx context.Context, state *sshPublicKeyResourceModel) diag.Diagnostics {
+				return setSshPublicKeyStateResource(ctx, &key, state)
+			})
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, test)
+	}
+}
+
+// testSetState reads a model from state, with all attributes null, sets it
+// with setState and saves it back to state.
+func testSetState[Model any](t *testing.T, state tfsdk.State, setState func(context.Context, *Model) diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	objectType := state.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	state.Raw = tftypes.NewValue(objectType, attributes)
+
+	var model Model
+	diags := state.Get(ctx, &model)
+	if !diags.HasError() {
+		diags.Append(setState(ctx, &model)...)
+	}
+	if !diags.HasError() {
+		diags.Append(state.Set(ctx, &model)...)
+	}
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// keyedMutex hands out one mutex per key, so that operations on the same
+// key are serialized while operations on different keys run concurrently.
+// Mutexes are kept for the lifetime of the provider, there is one per
+// firewall touched during a run.
+type keyedMutex struct {
+	mu sync.Mutex
+	// locks holds a channel with a buffer of one per key, which is full
+	// while the key is locked, so that waiting for it can be canceled.
+	locks map[string]chan struct{}
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: map[string]chan struct{}{}}
+}
+
+// Lock acquires the mutex of the key built from parts and returns the
+// function releasing it. It returns the error of ctx if ctx is done before
+// the mutex is acquired.
+func (km *keyedMutex) Lock(ctx context.Context, parts ...string) (func(), error) {
+	key := strings.Join(parts, "/")
+
+	km.mu.Lock()
+	lock, ok := km.locks[key]
+	if !ok {
+		lock = make(chan struct{}, 1)
+		km.locks[key] = lock
+	}
+	km.mu.Unlock()
+
+	select {
+	case lock <- struct{}{}:
+		return func() { <-lock }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestKeyedMutexSerializesSameKey(t *testing.T) {
+	km := newKeyedMutex()
+
+	var running, maxRunning int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock, err := km.Lock(context.Background(), "pj", "eu-central-h1", "fw")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer unlock()
+
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+		}()
+	}
+	wg.Wait()
+
+	if maxRunning != 1 {
+		t.Fatalf("expected operations on the same key to be serialized, got %d running concurrently", maxRunning)
+	}
+}
+
+func TestKeyedMutexDifferentKeysConcurrent(t *testing.T) {
+	km := newKeyedMutex()
+
+	unlock, err := km.Lock(context.Background(), "pj", "eu-central-h1", "fw1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unlock()
+
+	done := make(chan struct{})
+	go func() {
+		unlock, err := km.Lock(context.Background(), "pj", "eu-central-h1", "fw2")
+		if err == nil {
+			unlock()
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("lock on a different key blocked")
+	}
+}
+
+func TestKeyedMutexContextCanceled(t *testing.T) {
+	km := newKeyedMutex()
+
+	unlock, err := km.Lock(context.Background(), "pj", "eu-central-h1", "fw")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := km.Lock(ctx, "pj", "eu-central-h1", "fw"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the error of the context, got %v", err)
+	}
+
+	unlock()
+	unlock, err = km.Lock(context.Background(), "pj", "eu-central-h1", "fw")
+	if err != nil {
+		t.Fatalf("expected the lock to be free after a canceled wait, got %v", err)
+	}
+	unlock()
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parsePostgresUrlFunction{}
+
+const (
+	postgresDefaultPort    = 5432
+	postgresDefaultSslmode = "prefer"
+)
+
+func NewParsePostgresUrlFunction() function.Function {
+	return &parsePostgresUrlFunction{}
+}
+
+type parsePostgresUrlFunction struct{}
+
+type parsePostgresUrlModel struct {
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	User     types.String `tfsdk:"user"`
+	Password types.String `tfsdk:"password"`
+	Dbname   types.String `tfsdk:"dbname"`
+	Sslmode  types.String `tfsdk:"sslmode"`
+	Params   types.Map    `tfsdk:"params"`
+}
+
+func (f *parsePostgresUrlFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_postgres_url"
+}
+
+func (f *parsePostgresUrlFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses a Postgres connection URI into its components",
+		MarkdownDescription: "Parses a connection URI such as the `connection_string` of a Postgres database, following the rules of libpq: " +
+			"the scheme is `postgresql://` or `postgres://`, components are percent-decoded, IPv6 hosts are enclosed in brackets and " +
+			"query parameters override the components of the URI. Returns an object with the following attributes:\n\n" +
+			"- `host` (String) First host, null if there is none.\n" +
+			"- `port` (Number) Port of the first host, 5432 if not given.\n" +
+			"- `user` (String) User name, null if not given.\n" +
+			"- `password` (String) Password, null if not given.\n" +
+			"- `dbname` (String) Database name, null if not given.\n" +
+			"- `sslmode` (String) SSL mode, `prefer` if not given.\n" +
+			"- `params` (Map of String) All query parameters.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: "Postgres connection URI.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"host":     types.StringType,
+				"port":     types.Int64Type,
+				"user":     types.StringType,
+				"password": types.StringType,
+				"dbname":   types.StringType,
+				"sslmode":  types.StringType,
+				"params":   types.MapType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+func (f *parsePostgresUrlFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var postgresUrl string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &postgresUrl))
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := parsePostgresUrl(postgresUrl)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("Invalid Postgres URL: %s", err.Error())))
+		return
+	}
+
+	params, diags := types.MapValueFrom(ctx, types.StringType, parsed.params)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	result := parsePostgresUrlModel{
+		Host:     stringValueOrNull(parsed.host),
+		Port:     types.Int64Value(parsed.port),
+		User:     stringValueOrNull(parsed.user),
+		Password: stringValueOrNull(parsed.password),
+		Dbname:   stringValueOrNull(parsed.dbname),
+		Sslmode:  stringValueOrNull(&parsed.sslmode),
+		Params:   params,
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+func stringValueOrNull(value *string) types.String {
+	if value == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+type postgresUrl struct {
+	host     *string
+	port     int64
+	user     *string
+	password *string
+	dbname   *string
+	sslmode  string
+	params   map[string]string
+}
+
+// parsePostgresUrl parses a connection URI like libpq does, see
+// https://www.postgresql.org/docs/current/libpq-connect.html#LIBPQ-CONNSTRING-URIS.
+// Of several hosts, only the first one and its port are returned.
+func parsePostgresUrl(value string) (*postgresUrl, error) {
+	rest, ok := strings.CutPrefix(value, "postgresql://")
+	if !ok {
+		if rest, ok = strings.CutPrefix(value, "postgres://"); !ok {
+			return nil, fmt.Errorf("scheme must be postgresql:// or postgres://")
+		}
+	}
+
+	result := &postgresUrl{
+		port:    postgresDefaultPort,
+		sslmode: postgresDefaultSslmode,
+		params:  map[string]string{},
+	}
+
+	rest, query, hasQuery := strings.Cut(rest, "?")
+	netloc, dbname, hasDbname := strings.Cut(rest, "/")
+
+	if userinfo, hosts, hasUserinfo := strings.Cut(netloc, "@"); hasUserinfo {
+		user, password, hasPassword := strings.Cut(userinfo, ":")
+		if user != "" {
+			decoded, err := url.PathUnescape(user)
+			if err != nil {
+				return nil, fmt.Errorf("user: %s", err.Error())
+			}
+			result.user = &decoded
+		}
+		if hasPassword {
+			decoded, err := url.PathUnescape(password)
+			if err != nil {
+				return nil, fmt.Errorf("password: %s", err.Error())
+			}
+			result.password = &decoded
+		}
+		netloc = hosts
+	}
+
+	if netloc != "" {
+		host, port, err := parsePostgresUrlHost(strings.Split(netloc, ",")[0])
+		if err != nil {
+			return nil, err
+		}
+		if host != "" {
+			result.host = &host
+		}
+		if port != "" {
+			if err := result.setPort(port); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if hasDbname && dbname != "" {
+		decoded, err := url.PathUnescape(dbname)
+		if err != nil {
+			return nil, fmt.Errorf("dbname: %s", err.Error())
+		}
+		result.dbname = &decoded
+	}
+
+	if hasQuery && query != "" {
+		for _, param := range strings.Split(query, "&") {
+			key, value, ok := strings.Cut(param, "=")
+			if !ok || key == "" {
+				return nil, fmt.Errorf("query parameter %q must have the format key=value", param)
+			}
+			key, err := url.PathUnescape(key)
+			if err != nil {
+				return nil, fmt.Errorf("query parameter %q: %s", param, err.Error())
+			}
+			value, err = url.PathUnescape(value)
+			if err != nil {
+				return nil, fmt.Errorf("query parameter %q: %s", param, err.Error())
+			}
+			if err := result.setParam(key, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// parsePostgresUrlHost splits a host of a connection URI into the
+// percent-decoded host and the port, if any. IPv6 addresses are enclosed in
+// brackets.
+func parsePostgresUrlHost(value string) (string, string, error) {
+	var host, port string
+	if strings.HasPrefix(value, "[") {
+		end := strings.Index(value, "]")
+		if end < 0 {
+			return "", "", fmt.Errorf("missing ] in IPv6 host %q", value)
+		}
+		host = value[1:end]
+		if rest := value[end+1:]; rest != "" {
+			var ok bool
+			if port, ok = strings.CutPrefix(rest, ":"); !ok {
+				return "", "", fmt.Errorf("unexpected %q after IPv6 host %q", rest, host)
+			}
+		}
+	} else {
+		host, port, _ = strings.Cut(value, ":")
+	}
+
+	decoded, err := url.PathUnescape(host)
+	if err != nil {
+		return "", "", fmt.Errorf("host: %s", err.Error())
+	}
+	return decoded, port, nil
+}
+
+func (u *postgresUrl) setPort(value string) error {
+	port, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || port < 1 || port > 65535 {
+		return fmt.Errorf("invalid port %q", value)
+	}
+	u.port = port
+	return nil
+}
+
+// setParam sets a query parameter, which overrides the component of the URI
+// with the same name. As in libpq, ssl=true is a synonym of sslmode=require.
+func (u *postgresUrl) setParam(key, value string) error {
+	u.params[key] = value
+
+	switch key {
+	case "host":
+		host := strings.Split(value, ",")[0]
+		u.host = &host
+	case "port":
+		return u.setPort(strings.Split(value, ",")[0])
+	case "user":
+		u.user = &value
+	case "password":
+		u.password = &value
+	case "dbname":
+		u.dbname = &value
+	case "sslmode":
+		u.sslmode = value
+	case "ssl":
+		if value != "true" {
+			return fmt.Errorf("invalid value %q of query parameter ssl, only true is supported", value)
+		}
+		u.sslmode = "require"
+	}
+	return nil
+}
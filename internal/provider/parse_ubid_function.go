@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseUbidFunction{}
+
+// ubidAlphabet is the lowercase Crockford base32 alphabet, in which ids of
+// Ubicloud resources are encoded.
+const ubidAlphabet = "0123456789abcdefghjkmnpqrstvwxyz"
+
+const ubidLength = 26
+
+// ubidTimestampLayout formats the creation time of a resource, as RFC 3339
+// with the milliseconds the id holds.
+const ubidTimestampLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// ubidResourceTypes maps the type prefixes of ids to the resources of the
+// provider with such ids.
+var ubidResourceTypes = map[string]string{
+	"fr": "ubicloud_firewall_rule",
+	"fw": "ubicloud_firewall",
+	"pg": "ubicloud_postgres",
+	"pj": "ubicloud_project",
+	"ps": "ubicloud_private_subnet",
+	"vm": "ubicloud_vm",
+}
+
+func NewParseUbidFunction() function.Function {
+	return &parseUbidFunction{}
+}
+
+type parseUbidFunction struct{}
+
+type parseUbidModel struct {
+	Type         types.String `tfsdk:"type"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Timestamp    types.String `tfsdk:"timestamp"`
+}
+
+func (f *parseUbidFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_ubid"
+}
+
+func (f *parseUbidFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses the id of a Ubicloud resource",
+		MarkdownDescription: "Validates the id of a Ubicloud resource, a 26 character lowercase Crockford base32 string, and returns an object with the following attributes:\n\n" +
+			"- `type` (String) Type prefix of the id, e.g. `vm`, `pj`, `ps`, `pg` or `fw`.\n" +
+			"- `resource_type` (String) Terraform resource type of the provider for this kind of id, e.g. `ubicloud_vm`. Null if the provider has no such resource.\n" +
+			"- `timestamp` (String) Time the id was generated, usually the creation time of the resource, in RFC 3339 format in UTC, e.g. `2024-05-01T12:00:00.123Z`.\n\n" +
+			"This can be used to check that an id refers to the expected kind of resource, e.g. in the validation of a variable.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "ID of a Ubicloud resource.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"type":          types.StringType,
+				"resource_type": types.StringType,
+				"timestamp":     types.StringType,
+			},
+		},
+	}
+}
+
+func (f *parseUbidFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateUbid(id); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result := parseUbidModel{
+		Type:         types.StringValue(id[:2]),
+		ResourceType: types.StringNull(),
+		Timestamp:    types.StringValue(ubidTimestamp(id).Format(ubidTimestampLayout)),
+	}
+	if resourceType, ok := ubidResourceTypes[id[:2]]; ok {
+		result.ResourceType = types.StringValue(resourceType)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+func validateUbid(id string) error {
+	if len(id) != ubidLength {
+		return fmt.Errorf("invalid id %q: expected %d characters, got %d", id, ubidLength, len(id))
+	}
+
+	for i, c := range id {
+		if !strings.ContainsRune(ubidAlphabet, c) {
+			return fmt.Errorf("invalid id %q: character %q at position %d is not in the alphabet %s", id, c, i+1, ubidAlphabet)
+		}
+	}
+
+	// The 24 characters after the type prefix hold 120 bits, for the 118
+	// other bits of the UUID, so the first 2 are always zero.
+	if strings.IndexByte(ubidAlphabet, id[2]) > 7 {
+		return fmt.Errorf("invalid id %q: character %q at position 3 must be one of 0 to 7", id, id[2])
+	}
+	return nil
+}
+
+// ubidTimestamp returns the time a valid id was generated. An id is the type
+// prefix followed by the other 118 bits of the UUID it encodes, as a number
+// in 24 characters: after 2 zero bits, the first 48 are a Unix timestamp in
+// milliseconds.
+func ubidTimestamp(id string) time.Time {
+	var bits uint64
+	for _, c := range id[2:12] {
+		bits = bits<<5 | uint64(strings.IndexRune(ubidAlphabet, c))
+	}
+	// 10 characters hold 50 bits, the first 2 are the zero padding.
+	return time.UnixMilli(int64(bits & (1<<48 - 1))).UTC()
+}
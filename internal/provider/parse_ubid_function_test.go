@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseUbidFunction(t *testing.T) {
+	args := func(id string) []attr.Value {
+		return []attr.Value{types.StringValue(id)}
+	}
+	result := func(typ string, resourceType types.String, timestamp string) attr.Value {
+		return types.ObjectValueMust(
+			map[string]attr.Type{"type": types.StringType, "resource_type": types.StringType, "timestamp": types.StringType},
+			map[string]attr.Value{"type": types.StringValue(typ), "resource_type": resourceType, "timestamp": types.StringValue(timestamp)},
+		)
+	}
+
+	// The ids encode known times: the 10 characters after the type prefix are
+	// the timestamp, the remaining 14 random.
+	runFunctionTests(t, NewParseUbidFunction(), map[string]functionTestCase{
+		"project":       {arguments: args("pj01jjzcx7zzhmasw9nf6yy093"), expected: result("pj", types.StringValue("ubicloud_project"), "2025-01-31T23:59:59.999Z")},
+		"firewall rule": {arguments: args("fr01gvfq90e0k4r7z2m8q5w1x3"), expected: result("fr", types.StringValue("ubicloud_firewall_rule"), "2023-03-14T09:30:00.000Z")},
+		"vm":            {arguments: args("vm01hwt0d7kvmasw9nf6yy093m"), expected: result("vm", types.StringValue("ubicloud_vm"), "2024-05-01T12:00:00.123Z")},
+		"unknown type":  {arguments: args("nc01jjzcx7zzhmasw9nf6yy093"), expected: result("nc", types.StringNull(), "2025-01-31T23:59:59.999Z")},
+		"too short":     {arguments: args("pj01jjzcx7zzhmasw9nf6yy09"), error: true},
+		"uppercase":     {arguments: args("PJ01JJZCX7ZZHMASW9NF6YY093"), error: true},
+		"not crockford": {arguments: args("vmw12ouhqjdy4g72xng1ubkda6"), error: true},
+		"too many bits": {arguments: args("fr9wf3tra2r23nvsrcm5jkxb8d"), error: true},
+		"import id":     {arguments: args("pj01jjzcx7zzhmasw9nf6yy093,eu-central-h1,my-vm"), error: true},
+	})
+}
+
+func TestUbidTimestamp(t *testing.T) {
+	tests := map[string]time.Time{
+		"vm01hwt0d7kvmasw9nf6yy093m": time.Date(2024, 5, 1, 12, 0, 0, 123000000, time.UTC),
+		"pj01jjzcx7zzhmasw9nf6yy093": time.Date(2025, 1, 31, 23, 59, 59, 999000000, time.UTC),
+		"fr01gvfq90e0k4r7z2m8q5w1x3": time.Date(2023, 3, 14, 9, 30, 0, 0, time.UTC),
+		"pj000000000000000000000000": time.UnixMilli(0).UTC(),
+		"pj7zzzzzzzzz00000000000000": time.UnixMilli(1<<48 - 1).UTC(),
+	}
+
+	for id, expected := range tests {
+		if got := ubidTimestamp(id); !got.Equal(expected) {
+			t.Errorf("%s: expected %s, got %s", id, expected, got)
+		}
+	}
+}
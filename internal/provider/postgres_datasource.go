@@ -6,13 +6,10 @@ import (
 	"net/http"
 
 	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/datasource_postgres"
-	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_client"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -91,53 +88,7 @@ func (d *postgresDataSource) Read(ctx context.Context, req datasource.ReadReques
 }
 
 func setPostgresStateDatasource(ctx context.Context, postgresd *ubicloud_client.PostgresDetailed, state *datasource_postgres.PostgresModel) diag.Diagnostics {
-	assignStr(postgresd.Id, &state.Id)
-	assignStr(postgresd.Name, &state.Name)
-	assignStr(postgresd.State, &state.State)
-	assignStr(postgresd.Location, &state.Location)
-	assignStr(postgresd.VmSize, &state.VmSize)
-	assignStr(postgresd.VmSize, &state.VmSize)
-	assignInt(postgresd.StorageSizeGib, &state.StorageSizeGib)
-	assignBool(postgresd.Primary, &state.Primary)
-	assignStr(postgresd.HaType, &state.HaType)
-	assignStr(postgresd.Version, &state.Version)
-	assignStr(postgresd.ConnectionString, &state.ConnectionString)
-	assignStr(postgresd.EarliestRestoreTime, &state.EarliestRestoreTime)
-	assignStr(postgresd.LatestRestoreTime, &state.LatestRestoreTime)
-
-	firewallRulesListValue, diags := GetPostgresFirewallRulesState(ctx, postgresd.FirewallRules)
-	if diags.HasError() {
-		return diags
-	}
-
-	state.FirewallRules = firewallRulesListValue
-	return diags
-}
-
-func GetPostgresFirewallRulesState(ctx context.Context, firewallRules *[]ubicloud_client.PostgresFirewallRule) (basetypes.ListValue, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	firewallRulesValue := datasource_postgres.FirewallRulesValue{}
-	var firewallRulesValues []datasource_postgres.FirewallRulesValue
-	if firewallRules != nil && len(*firewallRules) > 0 {
-		for _, r := range *firewallRules {
-			fr := datasource_postgres.NewFirewallRulesValueMust(firewallRulesValue.AttributeTypes(ctx), map[string]attr.Value{
-				"id":   types.StringPointerValue(r.Id),
-				"cidr": types.StringPointerValue(r.Cidr),
-			})
-			firewallRulesValues = append(firewallRulesValues, fr)
-		}
-	} else {
-		firewallRulesValues = []datasource_postgres.FirewallRulesValue{}
-	}
-
-	firewallRulesListValue, diag := types.ListValueFrom(ctx, firewallRulesValue.Type(ctx), firewallRulesValues)
-	diags.Append(diag...)
-	if diags.HasError() {
-		return basetypes.NewListUnknown(firewallRulesValue.Type(ctx)), diags
-	}
-
-	return firewallRulesListValue, diags
+	return mapState(ctx, postgresd, state)
 }
 
 func postgresDataSourceLogIdentifier(state *datasource_postgres.PostgresModel) string {
@@ -8,10 +8,11 @@ import (
 )
 
 func TestAccPostgresDataSource(t *testing.T) {
-	resName := GetRandomResourceName("pg")
+	resName := GetRandomResourceName(t, "pg")
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
 		PreCheck:                 func() { TestAccPreCheck(t) },
+		CheckDestroy:             testAccCheckPostgresDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: providerConfig +
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/resource_postgres_firewall_rule"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewPostgresFirewallRuleResource() resource.Resource {
+	return &crudResource[postgresFirewallRuleResourceModel, ubicloud_client.PostgresFirewallRule]{
+		typeName:         "postgres_firewall_rule",
+		noun:             "postgres firewall rule",
+		schema:           postgresFirewallRuleResourceSchema,
+		importAttributes: []string{"project_id", "location", "postgres_name", "id"},
+		identifier:       postgresFirewallRuleResourceLogIdentifier,
+		create:           createPostgresFirewallRuleResource,
+		read:             readPostgresFirewallRuleResource,
+		delete:           deletePostgresFirewallRuleResource,
+		setState:         setPostgresFirewallRuleStateResource,
+	}
+}
+
+// postgresFirewallRuleResourceModel mirrors the generated
+// PostgresFirewallRuleModel, with a custom type for cidr so that equivalent
+// representations returned by the API do not show up as a diff.
+type postgresFirewallRuleResourceModel struct {
+	Cidr         cidrValue    `tfsdk:"cidr"`
+	Id           types.String `tfsdk:"id"`
+	Location     types.String `tfsdk:"location"`
+	PostgresName types.String `tfsdk:"postgres_name"`
+	ProjectId    types.String `tfsdk:"project_id"`
+}
+
+func postgresFirewallRuleResourceSchema(ctx context.Context) schema.Schema {
+	s := resource_postgres_firewall_rule.PostgresFirewallRuleResourceSchema(ctx)
+	s.Description = "Provides a Ubicloud PostgresFirewallRule resource. This can be used to create and delete firewall rules of PostgreSQL databases."
+
+	setStringCustomType(&s, "cidr", cidrType{})
+	addStringValidators(&s, "cidr", cidrValidator{})
+	return s
+}
+
+func createPostgresFirewallRuleResource(ctx context.Context, uc *UbicloudClient, state *postgresFirewallRuleResourceModel) (*ubicloud_client.PostgresFirewallRule, error) {
+	body := ubicloud_client.CreatePostgresFirewallRuleJSONRequestBody{
+		Cidr: state.Cidr.ValueString(),
+	}
+
+	firewallRuleResp, err := uc.client.CreatePostgresFirewallRuleWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.PostgresName.ValueString(), body)
+	if err != nil {
+		return nil, err
+	}
+	return firewallRuleResp.JSON200, ubicloud.CheckResponse(firewallRuleResp, firewallRuleResp.Body, http.StatusOK)
+}
+
+func readPostgresFirewallRuleResource(ctx context.Context, uc *UbicloudClient, state *postgresFirewallRuleResourceModel) (*ubicloud_client.PostgresFirewallRule, error) {
+	firewallRuleResp, err := uc.client.GetPostgresFirewallRuleDetailsWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.PostgresName.ValueString(), state.Id.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return firewallRuleResp.JSON200, ubicloud.CheckResponse(firewallRuleResp, firewallRuleResp.Body, http.StatusOK)
+}
+
+func deletePostgresFirewallRuleResource(ctx context.Context, uc *UbicloudClient, state *postgresFirewallRuleResourceModel) error {
+	firewallRuleResp, err := uc.client.DeletePostgresFirewallRuleWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.PostgresName.ValueString(), state.Id.ValueString())
+	if err != nil {
+		return err
+	}
+	return ubicloud.CheckResponse(firewallRuleResp, firewallRuleResp.Body, http.StatusNoContent)
+}
+
+func setPostgresFirewallRuleStateResource(ctx context.Context, firewallRule *ubicloud_client.PostgresFirewallRule, state *postgresFirewallRuleResourceModel) diag.Diagnostics {
+	return mapState(ctx, firewallRule, state)
+}
+
+func postgresFirewallRuleResourceLogIdentifier(state *postgresFirewallRuleResourceModel) string {
+	return fmt.Sprintf("project_id=%s, location=%s, postgres_name=%s, rule_id=%s", state.ProjectId.ValueString(), state.Location.ValueString(), state.PostgresName.ValueString(), state.Id.ValueString())
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+)
+
+func TestAccPostgresFirewallRuleResource(t *testing.T) {
+	resName := GetRandomResourceName(t, "pg")
+	resourceConfig := func(cidr string) string {
+		return fmt.Sprintf(`
+    resource "ubicloud_postgres" "testacc" {
+      project_id   = "%s"
+      location     = "%s"
+      name         = "%s"
+      size         = "standard-2"
+      storage_size = "64"
+    }
+
+    resource "ubicloud_postgres_firewall_rule" "testaccpgfwr1" {
+      project_id    = ubicloud_postgres.testacc.project_id
+      location      = ubicloud_postgres.testacc.location
+      postgres_name = ubicloud_postgres.testacc.name
+      cidr          = "%s"
+    }
+    `, GetTestAccProjectId(), GetTestAccLocation(), resName, cidr)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		CheckDestroy:             resource.ComposeAggregateTestCheckFunc(testAccCheckPostgresFirewallRuleDestroy(t), testAccCheckPostgresDestroy(t)),
+		Steps: []resource.TestStep{
+			// Test Create and Read
+			{
+				Config: providerConfig + resourceConfig("10.0.0.0/8"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ubicloud_postgres_firewall_rule.testaccpgfwr1", "id"),
+					resource.TestCheckResourceAttr("ubicloud_postgres_firewall_rule.testaccpgfwr1", "project_id", GetTestAccProjectId()),
+					resource.TestCheckResourceAttr("ubicloud_postgres_firewall_rule.testaccpgfwr1", "location", GetTestAccLocation()),
+					resource.TestCheckResourceAttr("ubicloud_postgres_firewall_rule.testaccpgfwr1", "postgres_name", resName),
+					resource.TestCheckResourceAttr("ubicloud_postgres_firewall_rule.testaccpgfwr1", "cidr", "10.0.0.0/8"),
+				),
+			},
+			// Test ImportState
+			{
+				ResourceName:      "ubicloud_postgres_firewall_rule.testaccpgfwr1",
+				ImportState:       true,
+				ImportStateIdFunc: postgresFirewallRuleImportStateIdFunc("ubicloud_postgres_firewall_rule.testaccpgfwr1"),
+				ImportStateVerify: true,
+			},
+			// Test that changing the CIDR replaces the rule
+			{
+				Config: providerConfig + resourceConfig("192.168.0.0/16"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ubicloud_postgres_firewall_rule.testaccpgfwr1", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ubicloud_postgres_firewall_rule.testaccpgfwr1", "cidr", "192.168.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+func postgresFirewallRuleImportStateIdFunc(pgfwr string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[pgfwr]
+
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", pgfwr)
+		}
+
+		if rs.Primary.ID == "" {
+			return "", fmt.Errorf("No Record ID is set")
+		}
+		return fmt.Sprintf("%s,%s,%s,%s", GetTestAccProjectId(), rs.Primary.Attributes["location"], rs.Primary.Attributes["postgres_name"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckPostgresFirewallRuleDestroy(t *testing.T) resource.TestCheckFunc {
+	return testAccCheckDestroy(t, "ubicloud_postgres_firewall_rule", func(ctx context.Context, client *ubicloud.Client, attrs map[string]string) (bool, error) {
+		resp, err := client.GetPostgresFirewallRuleDetailsWithResponse(ctx, attrs["project_id"], attrs["location"], attrs["postgres_name"], attrs["id"])
+		if err != nil {
+			return false, err
+		}
+		return testAccExists(resp.StatusCode(), resp.Body)
+	})
+}
@@ -4,68 +4,83 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/resource_postgres"
-	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_client"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var (
-	_ resource.Resource                = &postgresResource{}
-	_ resource.ResourceWithConfigure   = &postgresResource{}
-	_ resource.ResourceWithImportState = &postgresResource{}
-)
+var _ resource.ResourceWithValidateConfig = &postgresResource{}
 
 func NewPostgresResource() resource.Resource {
-	return &postgresResource{}
+	return &postgresResource{crudResource[postgresResourceModel, ubicloud_client.PostgresDetailed]{
+		typeName:         "postgres",
+		noun:             "postgres database",
+		schema:           postgresResourceSchema,
+		importAttributes: []string{"project_id", "location", "name"},
+		identifier:       postgresResourceLogIdentifier,
+		create:           createPostgresResource,
+		read:             readPostgresResource,
+		delete:           deletePostgresResource,
+		setState:         setPostgresStateResource,
+		waitReady:        waitPostgresResourceRunning,
+		waitDeleted:      waitNotFound(readPostgresResource),
+	}}
 }
 
+// postgresResource adds the validation of the configuration against the
+// catalog of the API.
 type postgresResource struct {
-	uc *UbicloudClient
-}
-
-func (r *postgresResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	uc, ok := req.ProviderData.(UbicloudClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *UbicloudClient, got: %T. Please report this issue to support@ubicloud.com.", req.ProviderData),
-		)
-		return
-	}
-
-	r.uc = &uc
+	crudResource[postgresResourceModel, ubicloud_client.PostgresDetailed]
 }
 
-func (r *postgresResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_postgres"
+// postgresResourceModel mirrors resource_postgres.PostgresModel and adds the
+// timeouts block.
+type postgresResourceModel struct {
+	FirewallRules  types.List     `tfsdk:"firewall_rules"`
+	HaType         types.String   `tfsdk:"ha_type"`
+	Id             types.String   `tfsdk:"id"`
+	Location       types.String   `tfsdk:"location"`
+	Name           types.String   `tfsdk:"name"`
+	Primary        types.Bool     `tfsdk:"primary"`
+	ProjectId      types.String   `tfsdk:"project_id"`
+	Size           types.String   `tfsdk:"size"`
+	StorageSize    types.Int64    `tfsdk:"storage_size"`
+	StorageSizeGib types.Int64    `tfsdk:"storage_size_gib"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	Version        types.String   `tfsdk:"version"`
+	VmSize         types.String   `tfsdk:"vm_size"`
 }
 
-func (r *postgresResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_postgres.PostgresResourceSchema(ctx)
-	resp.Schema.Description = "Provides a Ubicloud Postgres resource. This can be used to create and delete PostgreSQL databases."
+func postgresResourceSchema(ctx context.Context) schema.Schema {
+	s := resource_postgres.PostgresResourceSchema(ctx)
+	s.Description = "Provides a Ubicloud Postgres resource. This can be used to create and delete PostgreSQL databases."
+	addTimeoutsBlock(ctx, &s, timeouts.Opts{Create: true, Delete: true})
+	return s
 }
 
-func (r *postgresResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var state resource_postgres.PostgresModel
+func (r *postgresResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// The provider is not configured while Terraform validates the
+	// configuration on its own, the static catalog is used then.
+	c := r.uc.getCatalog(ctx)
+	location := configLocation(ctx, req.Config)
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	validateCatalogAttribute(ctx, req.Config, "location", c.locationValidator(), &resp.Diagnostics)
+	validateCatalogAttribute(ctx, req.Config, "size", c.optionValidator("Postgres size", location, func(l *catalogLocation) []string { return l.postgresSizes }), &resp.Diagnostics)
+	validateCatalogAttribute(ctx, req.Config, "ha_type", c.optionValidator("Postgres HA type", location, func(l *catalogLocation) []string { return l.postgresHaTypes }), &resp.Diagnostics)
+	validateCatalogAttribute(ctx, req.Config, "version", c.optionValidator("Postgres version", location, func(l *catalogLocation) []string { return l.postgresVersions }), &resp.Diagnostics)
+}
 
+func createPostgresResource(ctx context.Context, uc *UbicloudClient, state *postgresResourceModel) (*ubicloud_client.PostgresDetailed, error) {
 	storageSize := int(state.StorageSize.ValueInt64())
 	body := ubicloud_client.CreatePostgresDatabaseJSONRequestBody{
-		Size: state.Size.ValueString(),
+		Size:        state.Size.ValueString(),
 		StorageSize: &storageSize,
 	}
 	if state.HaType.ValueString() != "" {
@@ -75,140 +90,42 @@ func (r *postgresResource) Create(ctx context.Context, req resource.CreateReques
 		body.Version = state.Version.ValueStringPointer()
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Creating postgres database: %s", postgresResourceLogIdentifier(&state)))
-	postgresResp, err := r.uc.client.CreatePostgresDatabaseWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString(), body)
+	postgresResp, err := uc.client.CreatePostgresDatabaseWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString(), body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error creating postgres database: %s", postgresResourceLogIdentifier(&state)),
-			err.Error(),
-		)
-		return
+		return nil, err
 	}
-
-	if postgresResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code creating postgres database",
-			fmt.Sprintf("Received %s for postgres database: %s. Details: %s", postgresResp.Status(), postgresResourceLogIdentifier(&state), postgresResp.Body))
-		return
-	}
-
-	diags := setPostgresStateResource(ctx, postgresResp.JSON200, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	return postgresResp.JSON200, ubicloud.CheckResponse(postgresResp, postgresResp.Body, http.StatusOK)
 }
 
-func (r *postgresResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_postgres.PostgresModel
-
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	tflog.Debug(ctx, fmt.Sprintf("Reading postgres database: %s", postgresResourceLogIdentifier(&state)))
-	postgresResp, err := r.uc.client.GetPostgresDatabaseDetailsWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
+func readPostgresResource(ctx context.Context, uc *UbicloudClient, state *postgresResourceModel) (*ubicloud_client.PostgresDetailed, error) {
+	postgresResp, err := uc.client.GetPostgresDatabaseDetailsWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error reading postgres database: %s", postgresResourceLogIdentifier(&state)),
-			err.Error(),
-		)
-		return
-	}
-
-	if postgresResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading postgres database",
-			fmt.Sprintf("Received %s for postgres database: %s. Details: %s", postgresResp.Status(), postgresResourceLogIdentifier(&state), postgresResp.Body))
-		return
+		return nil, err
 	}
-
-	diags := setPostgresStateResource(ctx, postgresResp.JSON200, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	return postgresResp.JSON200, ubicloud.CheckResponse(postgresResp, postgresResp.Body, http.StatusOK)
 }
 
-func (r *postgresResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state resource_postgres.PostgresModel
-
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.AddError(
-		"Update of postgres is not supported",
-		fmt.Sprintf("Cannot update postgres database: %s", postgresResourceLogIdentifier(&state)))
-}
-
-func (r *postgresResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_postgres.PostgresModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	tflog.Debug(ctx, fmt.Sprintf("Deleting postgres database: %s", postgresResourceLogIdentifier(&state)))
-	postgresResp, err := r.uc.client.DeletePostgresDatabaseWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
+func deletePostgresResource(ctx context.Context, uc *UbicloudClient, state *postgresResourceModel) error {
+	postgresResp, err := uc.client.DeletePostgresDatabaseWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error deleting postgres database: %s", postgresResourceLogIdentifier(&state)),
-			err.Error(),
-		)
-		return
-	}
-
-	if postgresResp.StatusCode() != http.StatusNoContent && postgresResp.StatusCode() != http.StatusNotFound {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code deleting postgres database",
-			fmt.Sprintf("Received %s for postgres database: %s. Details: %s", postgresResp.Status(), postgresResourceLogIdentifier(&state), postgresResp.Body))
-		return
+		return err
 	}
+	return ubicloud.CheckResponse(postgresResp, postgresResp.Body, http.StatusNoContent)
 }
 
-func (r *postgresResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: project_id,location,name. Got: %q", req.ID),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("location"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[2])...)
+// waitPostgresResourceRunning waits until the created database is running.
+func waitPostgresResourceRunning(ctx context.Context, uc *UbicloudClient, state *postgresResourceModel) (*ubicloud_client.PostgresDetailed, error) {
+	return uc.client.WaitForPostgresState(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString(), "running", waitInterval)
 }
 
-func setPostgresStateResource(ctx context.Context, postgresd *ubicloud_client.PostgresDetailed, state *resource_postgres.PostgresModel) diag.Diagnostics {
-	assignStr(postgresd.Id, &state.Id)
-	assignStr(postgresd.Name, &state.Name)
-	assignStr(postgresd.Location, &state.Location)
-	assignStr(postgresd.VmSize, &state.VmSize)
-	assignStr(postgresd.VmSize, &state.Size)
-	assignInt(postgresd.StorageSizeGib, &state.StorageSizeGib)
-	assignBool(postgresd.Primary, &state.Primary)
-	assignStr(postgresd.HaType, &state.HaType)
-	assignStr(postgresd.Version, &state.Version)
-
-	firewallRulesListValue, diags := GetPostgresFirewallRulesState(ctx, postgresd.FirewallRules)
-	if diags.HasError() {
-		return diags
-	}
+func setPostgresStateResource(ctx context.Context, postgresd *ubicloud_client.PostgresDetailed, state *postgresResourceModel) diag.Diagnostics {
+	diags := mapState(ctx, postgresd, state)
 
-	state.FirewallRules = firewallRulesListValue
+	// size is the alias of vm_size used to create the database.
+	assignStr(postgresd.VmSize, &state.Size)
 	return diags
 }
 
-func postgresResourceLogIdentifier(state *resource_postgres.PostgresModel) string {
+func postgresResourceLogIdentifier(state *postgresResourceModel) string {
 	return fmt.Sprintf("project_id=%s, location=%s, name=%s", state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
 }
@@ -1,30 +1,37 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
 )
 
 func TestAccPostgresResource(t *testing.T) {
-	resName := GetRandomResourceName("pg")
-	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		Steps: []resource.TestStep{
-			// Test Create and Read
-			{
-				Config: providerConfig +
-					fmt.Sprintf(`
+	resName := GetRandomResourceName(t, "pg")
+	resourceConfig := func(storageSize int) string {
+		return fmt.Sprintf(`
         resource "ubicloud_postgres" "testacc" {
           project_id   = "%s"
           location     = "%s"
           name         = "%s"
           size         = "standard-2"
-		  storage_size = "64"
+		  storage_size = "%d"
 		  version      = "17"
-        }`, GetTestAccProjectId(), GetTestAccLocation(), resName),
+        }`, GetTestAccProjectId(), GetTestAccLocation(), resName, storageSize)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		CheckDestroy:             testAccCheckPostgresDestroy(t),
+		Steps: []resource.TestStep{
+			// Test Create and Read
+			{
+				Config: providerConfig + resourceConfig(64),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("ubicloud_postgres.testacc", "id"),
 					resource.TestCheckResourceAttr("ubicloud_postgres.testacc", "project_id", GetTestAccProjectId()),
@@ -46,8 +53,32 @@ func TestAccPostgresResource(t *testing.T) {
 				ImportStateIdFunc: func(state *terraform.State) (string, error) {
 					return fmt.Sprintf("%s,%s,%s", GetTestAccProjectId(), GetTestAccLocation(), resName), nil
 				},
-				ImportStateVerify: false,
+				ImportStateVerify: true,
+				// The API doesn't return the requested storage size.
+				ImportStateVerifyIgnore: []string{"storage_size"},
+			},
+			// Test that resizing the storage replaces the database
+			{
+				Config: providerConfig + resourceConfig(128),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ubicloud_postgres.testacc", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ubicloud_postgres.testacc", "storage_size_gib", "128"),
+				),
 			},
 		},
 	})
 }
+
+func testAccCheckPostgresDestroy(t *testing.T) resource.TestCheckFunc {
+	return testAccCheckDestroy(t, "ubicloud_postgres", func(ctx context.Context, client *ubicloud.Client, attrs map[string]string) (bool, error) {
+		resp, err := client.GetPostgresDetailsWithIdWithResponse(ctx, attrs["project_id"], attrs["location"], attrs["id"])
+		if err != nil {
+			return false, err
+		}
+		return testAccExists(resp.StatusCode(), resp.Body)
+	})
+}
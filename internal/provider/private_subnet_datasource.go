@@ -6,13 +6,10 @@ import (
 	"net/http"
 
 	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/datasource_private_subnet"
-	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_client"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -91,52 +88,7 @@ func (d *privateSubnetDataSource) Read(ctx context.Context, req datasource.ReadR
 }
 
 func setPrivateSubnetStateDatasource(ctx context.Context, ps *ubicloud_client.PrivateSubnet, state *datasource_private_subnet.PrivateSubnetModel) diag.Diagnostics {
-	assignStr(ps.Id, &state.Id)
-	assignStr(ps.Net4, &state.Net4)
-	assignStr(ps.Net6, &state.Net6)
-
-	nicsListValue, diags := GetNicsState(ctx, ps.Nics)
-	if diags.HasError() {
-		return diags
-	}
-	state.Nics = nicsListValue
-
-	firewallsListValue, diagsFw := GetFirewallsState(ctx, ps.Firewalls)
-	diags.Append(diagsFw...)
-	if diags.HasError() {
-		return diags
-	}
-
-	state.Firewalls = firewallsListValue
-	return diags
-}
-
-func GetNicsState(ctx context.Context, nics *[]ubicloud_client.Nic) (basetypes.ListValue, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	nicsValue := datasource_private_subnet.NicsValue{}
-	var nicsValues []datasource_private_subnet.NicsValue
-	if nics != nil && len(*nics) > 0 {
-		for _, n := range *nics {
-			nv := datasource_private_subnet.NewNicsValueMust(nicsValue.AttributeTypes(ctx), map[string]attr.Value{
-				"id":           types.StringPointerValue(n.Id),
-				"name":         types.StringPointerValue(n.Name),
-				"private_ipv4": types.StringPointerValue(n.PrivateIpv4),
-				"private_ipv6": types.StringPointerValue(n.PrivateIpv6),
-				"vm_name":      types.StringPointerValue(n.VmName),
-			})
-			nicsValues = append(nicsValues, nv)
-		}
-	} else {
-		nicsValues = []datasource_private_subnet.NicsValue{}
-	}
-
-	nicsListValue, diag := types.ListValueFrom(ctx, nicsValue.Type(ctx), nicsValues)
-	diags.Append(diag...)
-	if diags.HasError() {
-		return basetypes.NewListUnknown(nicsValue.Type(ctx)), diags
-	}
-
-	return nicsListValue, diags
+	return mapState(ctx, ps, state)
 }
 
 func privateSubnetDataSourceLogIdentifier(state *datasource_private_subnet.PrivateSubnetModel) string {
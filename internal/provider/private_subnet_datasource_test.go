@@ -1,33 +1,25 @@
 package provider
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccPrivateSubnetDataSource(t *testing.T) {
-	resName := GetRandomResourceName("sn")
+	resName := GetRandomResourceName(t, "sn")
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
 		PreCheck:                 func() { TestAccPreCheck(t) },
+		CheckDestroy:             resource.ComposeAggregateTestCheckFunc(testAccCheckPrivateSubnetDestroy(t), testAccCheckFirewallDestroy(t)),
 		Steps: []resource.TestStep{
 			{
-				Config: providerConfig +
-					fmt.Sprintf(`
-				resource "ubicloud_private_subnet" "testacc" {
-					project_id  = "%s"
-					location    = "%s"
-					firewall_id = "%s"
-					name        = "%s"
-				}
-				
+				Config: providerConfig + testAccPrivateSubnetConfig(resName, GetRandomResourceName(t, "fw")) + `
 				data "ubicloud_private_subnet" "testacc" {
-				  project_id = ubicloud_private_subnet.testacc.project_id
-					location = ubicloud_private_subnet.testacc.location
-					name = ubicloud_private_subnet.testacc.name
-				}`, GetTestAccProjectId(), GetTestAccLocation(), GetTestAccFirewallId(), resName),
+					project_id = ubicloud_private_subnet.testacc.project_id
+					location   = ubicloud_private_subnet.testacc.location
+					name       = ubicloud_private_subnet.testacc.name
+				}`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.ubicloud_private_subnet.testacc", "id"),
 					resource.TestCheckResourceAttr("data.ubicloud_private_subnet.testacc", "project_id", GetTestAccProjectId()),
@@ -1,33 +1,32 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
 )
 
 func TestAccPrivateSubnetResource(t *testing.T) {
-	resName := GetRandomResourceName("sn")
+	resName := GetRandomResourceName(t, "sn")
+	fwName := GetRandomResourceName(t, "fw")
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		CheckDestroy:             resource.ComposeAggregateTestCheckFunc(testAccCheckPrivateSubnetDestroy(t), testAccCheckFirewallDestroy(t)),
 		Steps: []resource.TestStep{
 			// Test Create and Read
 			{
-				Config: providerConfig +
-					fmt.Sprintf(`
-        resource "ubicloud_private_subnet" "testacc" {
-          project_id  = "%s"
-          location    = "%s"
-          firewall_id = "%s"
-          name        = "%s"
-        }`, GetTestAccProjectId(), GetTestAccLocation(), GetTestAccFirewallId(), resName),
+				Config: providerConfig + testAccPrivateSubnetConfig(resName, fwName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("ubicloud_private_subnet.testacc", "id"),
 					resource.TestCheckResourceAttr("ubicloud_private_subnet.testacc", "project_id", GetTestAccProjectId()),
 					resource.TestCheckResourceAttr("ubicloud_private_subnet.testacc", "location", GetTestAccLocation()),
 					resource.TestCheckResourceAttr("ubicloud_private_subnet.testacc", "name", resName),
+					resource.TestCheckResourceAttrPair("ubicloud_private_subnet.testacc", "firewall_id", "ubicloud_firewall.testacc", "id"),
 					resource.TestCheckResourceAttr("ubicloud_private_subnet.testacc", "firewall_rules.#", "0"),
 					resource.TestCheckResourceAttr("ubicloud_private_subnet.testacc", "nics.#", "0"),
 				),
@@ -39,7 +38,51 @@ func TestAccPrivateSubnetResource(t *testing.T) {
 				ImportStateIdFunc: func(state *terraform.State) (string, error) {
 					return fmt.Sprintf("%s,%s,%s", GetTestAccProjectId(), GetTestAccLocation(), resName), nil
 				},
+				ImportStateVerify: true,
+				// The API doesn't return the firewall a subnet was created with.
+				ImportStateVerifyIgnore: []string{"firewall_id"},
+			},
+			// Test that renaming the subnet replaces it
+			{
+				Config: providerConfig + testAccPrivateSubnetConfig(resName+"-renamed", fwName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ubicloud_private_subnet.testacc", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ubicloud_private_subnet.testacc", "name", resName+"-renamed"),
+				),
 			},
 		},
 	})
 }
+
+// testAccPrivateSubnetConfig returns the configuration of a private subnet
+// named name, protected by a firewall named firewallName without rules.
+func testAccPrivateSubnetConfig(name string, firewallName string) string {
+	return fmt.Sprintf(`
+    resource "ubicloud_firewall" "testacc" {
+      project_id = "%s"
+      location   = "%s"
+      name       = "%s"
+    }
+
+    resource "ubicloud_private_subnet" "testacc" {
+      project_id  = ubicloud_firewall.testacc.project_id
+      location    = ubicloud_firewall.testacc.location
+      firewall_id = ubicloud_firewall.testacc.id
+      name        = "%s"
+    }
+    `, GetTestAccProjectId(), GetTestAccLocation(), firewallName, name)
+}
+
+func testAccCheckPrivateSubnetDestroy(t *testing.T) resource.TestCheckFunc {
+	return testAccCheckDestroy(t, "ubicloud_private_subnet", func(ctx context.Context, client *ubicloud.Client, attrs map[string]string) (bool, error) {
+		resp, err := client.GetPSDetailsWithIdWithResponse(ctx, attrs["project_id"], attrs["location"], attrs["id"])
+		if err != nil {
+			return false, err
+		}
+		return testAccExists(resp.StatusCode(), resp.Body)
+	})
+}
@@ -76,10 +76,10 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	assignStr(projectResp.JSON200.Id, &state.Id)
-	assignStr(projectResp.JSON200.Name, &state.Name)
-	assignInt(projectResp.JSON200.Discount, &state.Discount)
-	assignFloat(projectResp.JSON200.Credit, &state.Credit)
+	resp.Diagnostics.Append(mapState(ctx, projectResp.JSON200, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
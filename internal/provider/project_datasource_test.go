@@ -8,21 +8,27 @@ import (
 )
 
 func TestAccProjectDataSource(t *testing.T) {
+	resName := GetRandomResourceName(t, "project")
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
 		PreCheck:                 func() { TestAccPreCheck(t) },
+		CheckDestroy:             testAccCheckProjectDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: providerConfig +
 					fmt.Sprintf(`
+        resource "ubicloud_project" "testacc" {
+          name = "%s"
+        }
+
         data "ubicloud_project" "testacc" {
-          id = "%s"
-        }`, GetTestAccProjectId()),
+          id = ubicloud_project.testacc.id
+        }`, resName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.ubicloud_project.testacc", "id", GetTestAccProjectId()),
-					resource.TestCheckResourceAttr("data.ubicloud_project.testacc", "name", "Terraform"),
-					resource.TestCheckResourceAttr("data.ubicloud_project.testacc", "credit", "0"),
-					resource.TestCheckResourceAttr("data.ubicloud_project.testacc", "discount", "100"),
+					resource.TestCheckResourceAttrPair("data.ubicloud_project.testacc", "id", "ubicloud_project.testacc", "id"),
+					resource.TestCheckResourceAttr("data.ubicloud_project.testacc", "name", resName),
+					resource.TestCheckResourceAttrPair("data.ubicloud_project.testacc", "credit", "ubicloud_project.testacc", "credit"),
+					resource.TestCheckResourceAttrPair("data.ubicloud_project.testacc", "discount", "ubicloud_project.testacc", "discount"),
 				),
 			},
 		},
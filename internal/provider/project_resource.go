@@ -6,160 +6,66 @@ import (
 	"net/http"
 
 	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/resource_project"
-	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_client"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-)
-
-var (
-	_ resource.Resource                = &projectResource{}
-	_ resource.ResourceWithConfigure   = &projectResource{}
-	_ resource.ResourceWithImportState = &projectResource{}
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
 func NewProjectResource() resource.Resource {
-	return &projectResource{}
-}
-
-type projectResource struct {
-	uc *UbicloudClient
-}
-
-func (r *projectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	uc, ok := req.ProviderData.(UbicloudClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *UbicloudClient, got: %T. Please report this issue to support@ubicloud.com.", req.ProviderData),
-		)
-
-		return
+	return &crudResource[resource_project.ProjectModel, ubicloud_client.Project]{
+		typeName:         "project",
+		noun:             "project",
+		schema:           projectResourceSchema,
+		importAttributes: []string{"id"},
+		identifier:       projectResourceLogIdentifier,
+		create:           createProjectResource,
+		read:             readProjectResource,
+		delete:           deleteProjectResource,
+		setState:         setProjectStateResource,
 	}
-
-	r.uc = &uc
 }
 
-func (r *projectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_project"
+func projectResourceSchema(ctx context.Context) schema.Schema {
+	s := resource_project.ProjectResourceSchema(ctx)
+	s.Description = "Provides a Ubicloud Project resource. This can be used to create and delete projects."
+	return s
 }
 
-func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_project.ProjectResourceSchema(ctx)
-	resp.Schema.Description = "Provides a Ubicloud Project resource. This can be used to create and delete projects."
-
-}
-
-func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var state resource_project.ProjectModel
-
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+func createProjectResource(ctx context.Context, uc *UbicloudClient, state *resource_project.ProjectModel) (*ubicloud_client.Project, error) {
 	body := ubicloud_client.CreateProjectJSONRequestBody{Name: state.Name.ValueString()}
 
-	tflog.Debug(ctx, "Creating project")
-	projectResp, err := r.uc.client.CreateProjectWithResponse(ctx, body)
+	projectResp, err := uc.client.CreateProjectWithResponse(ctx, body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating project",
-			err.Error(),
-		)
-		return
-	}
-
-	if projectResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code creating project",
-			fmt.Sprintf("Received %s creating project. Details: %s", projectResp.Status(), projectResp.Body))
-		return
+		return nil, err
 	}
-
-	assignStr(projectResp.JSON200.Id, &state.Id)
-	assignStr(projectResp.JSON200.Name, &state.Name)
-	assignInt(projectResp.JSON200.Discount, &state.Discount)
-	assignFloat(projectResp.JSON200.Credit, &state.Credit)
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	return projectResp.JSON200, ubicloud.CheckResponse(projectResp, projectResp.Body, http.StatusOK)
 }
 
-func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_project.ProjectModel
-
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	tflog.Debug(ctx, fmt.Sprintf("Reading project: project_id=%s", state.Id.ValueString()))
-	projectResp, err := r.uc.client.GetProjectWithResponse(ctx, state.Id.ValueString())
+func readProjectResource(ctx context.Context, uc *UbicloudClient, state *resource_project.ProjectModel) (*ubicloud_client.Project, error) {
+	projectResp, err := uc.client.GetProjectWithResponse(ctx, state.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error reading project: project_id=%s", state.Id.ValueString()),
-			err.Error(),
-		)
-		return
+		return nil, err
 	}
-
-	if projectResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading project",
-			fmt.Sprintf("Received %s for project: project_id=%s. Details: %s", projectResp.Status(), state.Id.ValueString(), projectResp.Body))
-		return
-	}
-
-	assignStr(projectResp.JSON200.Id, &state.Id)
-	assignStr(projectResp.JSON200.Name, &state.Name)
-	assignInt(projectResp.JSON200.Discount, &state.Discount)
-	assignFloat(projectResp.JSON200.Credit, &state.Credit)
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	return projectResp.JSON200, ubicloud.CheckResponse(projectResp, projectResp.Body, http.StatusOK)
 }
 
-func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state resource_project.ProjectModel
-
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.AddError(
-		"Update of project is not supported",
-		fmt.Sprintf("Cannot update project: project_id=%s", state.Id.ValueString()))
-}
-
-func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_project.ProjectModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	tflog.Debug(ctx, fmt.Sprintf("Deleting project: project_id=%s", state.Id.ValueString()))
-	projectResp, err := r.uc.client.DeleteProjectWithResponse(ctx, state.Id.ValueString())
+func deleteProjectResource(ctx context.Context, uc *UbicloudClient, state *resource_project.ProjectModel) error {
+	projectResp, err := uc.client.DeleteProjectWithResponse(ctx, state.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error deleting project: project_id=%s", state.Id.ValueString()),
-			err.Error(),
-		)
-		return
+		return err
 	}
+	return ubicloud.CheckResponse(projectResp, projectResp.Body, http.StatusNoContent)
+}
 
-	if projectResp.StatusCode() != http.StatusNoContent && projectResp.StatusCode() != http.StatusNotFound {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code deleting project",
-			fmt.Sprintf("Received %s for project: project_id=%s. Details: %s", projectResp.Status(), state.Id.ValueString(), projectResp.Body))
-		return
-	}
+func setProjectStateResource(ctx context.Context, project *ubicloud_client.Project, state *resource_project.ProjectModel) diag.Diagnostics {
+	return mapState(ctx, project, state)
 }
 
-func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+// projectResourceLogIdentifier includes the name, as the ID of a project is
+// unknown until it is created.
+func projectResourceLogIdentifier(state *resource_project.ProjectModel) string {
+	return fmt.Sprintf("project_id=%s, name=%s", state.Id.ValueString(), state.Name.ValueString())
 }
@@ -1,25 +1,35 @@
 package provider
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
 )
 
 func TestAccProjectResource(t *testing.T) {
+	resName := GetRandomResourceName(t, "project")
+	resourceConfig := func(name string) string {
+		return fmt.Sprintf(`
+        resource "ubicloud_project" "testacc" {
+            name = "%s"
+        }
+        `, name)
+	}
+
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		CheckDestroy:             testAccCheckProjectDestroy(t),
 		Steps: []resource.TestStep{
 			// Test Create and Read
 			{
-				Config: providerConfig + `
-        resource "ubicloud_project" "testacc" {
-            name = "TerraformAccTest"
-        }
-        `,
+				Config: providerConfig + resourceConfig(resName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("ubicloud_project.testacc", "id"),
-					resource.TestCheckResourceAttr("ubicloud_project.testacc", "name", "TerraformAccTest"),
+					resource.TestCheckResourceAttr("ubicloud_project.testacc", "name", resName),
 					resource.TestCheckResourceAttrSet("ubicloud_project.testacc", "credit"),
 					resource.TestCheckResourceAttrSet("ubicloud_project.testacc", "discount"),
 				),
@@ -30,6 +40,28 @@ func TestAccProjectResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Test that renaming the project replaces it
+			{
+				Config: providerConfig + resourceConfig(resName+"-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ubicloud_project.testacc", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ubicloud_project.testacc", "name", resName+"-renamed"),
+				),
+			},
 		},
 	})
 }
+
+func testAccCheckProjectDestroy(t *testing.T) resource.TestCheckFunc {
+	return testAccCheckDestroy(t, "ubicloud_project", func(ctx context.Context, client *ubicloud.Client, attrs map[string]string) (bool, error) {
+		resp, err := client.GetProjectWithResponse(ctx, attrs["id"])
+		if err != nil {
+			return false, err
+		}
+		return testAccExists(resp.StatusCode(), resp.Body)
+	})
+}
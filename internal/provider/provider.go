@@ -4,9 +4,10 @@ import (
 	"context"
 	"os"
 
-	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_client"
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/strictapi"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
 
-	"github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure UbicloudProvider satisfies various provider interfaces.
@@ -22,7 +24,21 @@ var _ provider.ProviderWithFunctions = &ubicloudProvider{}
 
 type UbicloudClient struct {
 	endpoint string
-	client   *ubicloud_client.ClientWithResponses
+	client   *ubicloud.Client
+	// firewallMutex serializes changes to the rules of a firewall. It is a
+	// pointer, so that it is shared between the copies handed to resources.
+	firewallMutex *keyedMutex
+	// catalog caches the locations and sizes used to validate
+	// configurations, fetched once per provider instance when it succeeds.
+	// Failures are cached for a minute.
+	catalog *catalogCache
+}
+
+// lockFirewall serializes rule creates and deletes on the same firewall, as
+// the API may lose rules when they are changed concurrently. It returns the
+// function releasing the lock, or the error of ctx if it is done first.
+func (uc *UbicloudClient) lockFirewall(ctx context.Context, projectId string, location string, firewallName string) (func(), error) {
+	return uc.firewallMutex.Lock(ctx, projectId, location, firewallName)
 }
 
 type ubicloudProvider struct {
@@ -30,6 +46,12 @@ type ubicloudProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+	// httpClient sends the requests of the API client if set, e.g. to record
+	// or replay them in acceptance tests.
+	httpClient ubicloud_client.HttpRequestDoer
+	// reportAPIMismatch is called for API responses that don't match the
+	// OpenAPI spec in strict mode, instead of logging a warning.
+	reportAPIMismatch strictapi.ReportFunc
 }
 
 // UbicloudProviderModel describes the provider data model.
@@ -102,7 +124,7 @@ func (p *ubicloudProvider) Configure(ctx context.Context, req provider.Configure
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 	if endpoint == "" {
-		endpoint = "https://api.ubicloud.com"
+		endpoint = ubicloud.DefaultEndpoint
 	}
 	if token == "" {
 		resp.Diagnostics.AddAttributeError(
@@ -116,21 +138,39 @@ func (p *ubicloudProvider) Configure(ctx context.Context, req provider.Configure
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	auth, err := securityprovider.NewSecurityProviderBearerToken(token)
+	httpClient := p.httpClient
+	if strictapi.Enabled() {
+		report := p.reportAPIMismatch
+		if report == nil {
+			report = func(ctx context.Context, err error) {
+				tflog.Warn(ctx, "Ubicloud API response does not match the OpenAPI spec", map[string]any{"error": err.Error()})
+			}
+		}
+		strictClient, err := strictapi.NewClient(httpClient, report)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create Ubicloud client", err.Error())
+			return
+		}
+		httpClient = strictClient
+	}
+
+	client, err := ubicloud.NewClient(token, ubicloud.WithEndpoint(endpoint), ubicloud.WithHTTPClient(httpClient))
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to create security provider with supplied token", err.Error())
+		resp.Diagnostics.AddError("Failed to create Ubicloud client", err.Error())
 		return
 	}
 
-	client, err := ubicloud_client.NewClientWithResponses(endpoint, ubicloud_client.WithRequestEditorFn(auth.Intercept))
+	catalogClient, err := ubicloud.NewClient(token, ubicloud.WithEndpoint(endpoint), ubicloud.WithHTTPClient(httpClient), ubicloud.WithRetryPolicy(ubicloud.RetryPolicy{}))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create Ubicloud client", err.Error())
 		return
 	}
 
 	ubicloudClient := UbicloudClient{
-		endpoint: endpoint,
-		client:   client,
+		endpoint:      endpoint,
+		client:        client,
+		firewallMutex: newKeyedMutex(),
+		catalog:       &catalogCache{client: catalogClient},
 	}
 
 	resp.DataSourceData = ubicloudClient
@@ -140,10 +180,13 @@ func (p *ubicloudProvider) Configure(ctx context.Context, req provider.Configure
 func (p *ubicloudProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewFirewallResource,
+		NewFirewallAttachmentResource,
 		NewFirewallRuleResource,
 		NewPostgresResource,
+		NewPostgresFirewallRuleResource,
 		NewPrivateSubnetResource,
 		NewProjectResource,
+		NewSshPublicKeyResource,
 		NewVmResource,
 	}
 }
@@ -160,7 +203,15 @@ func (p *ubicloudProvider) DataSources(ctx context.Context) []func() datasource.
 }
 
 func (p *ubicloudProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewCidrContainsFunction,
+		NewCidrHostFunction,
+		NewCidrOverlapsFunction,
+		NewImportIdFunction,
+		NewIpv6InterfaceIdFunction,
+		NewParsePostgresUrlFunction,
+		NewParseUbidFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -1,13 +1,24 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"hash/fnv"
 	"math/rand"
+	"net/http"
 	"os"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/fakeapi"
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/strictapi"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
 )
 
 const (
@@ -17,14 +28,96 @@ provider "ubicloud" {
 `
 )
 
-var (
-	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-		"ubicloud": providerserver.NewProtocol6WithError(New("test")()),
+// testAccRecorders holds the recorder of each test, shared by the provider
+// instances of all its steps.
+var testAccRecorders sync.Map
+
+// testAccProtoV6ProviderFactories returns the provider factories of an
+// acceptance test, recording or replaying its API requests if
+// UBICLOUD_ACC_RECORD is set.
+func testAccProtoV6ProviderFactories(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	t.Helper()
+
+	p := &ubicloudProvider{version: "test"}
+	if os.Getenv("UBICLOUD_ACC_FAKE_API") != "" {
+		p.reportAPIMismatch = func(_ context.Context, err error) {
+			t.Errorf("API response does not match the OpenAPI spec: %s", err)
+		}
+	}
+	if r, ok := testAccRecorders.Load(t.Name()); ok {
+		p.httpClient = r.(*recorder)
+	} else if r := newTestAccRecorder(t); r != nil {
+		testAccRecorders.Store(t.Name(), r)
+		p.httpClient = r
 	}
-)
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"ubicloud": providerserver.NewProtocol6WithError(p),
+	}
+}
 
 const TestAccNamePrefix = "tf-acc"
 
+// testAccPublicKey is an SSH public key for test VMs, its private key is not
+// kept anywhere.
+const (
+	testAccPublicKey            = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOZcY1oV+yAOQ5xPMfL50C20R+yWUIPZTXtnp+NkMruY tf-acc"
+	testAccPublicKeyFingerprint = "SHA256:h4g65MWDB5nMWKJmm7L5KrAQaC8oWfD2FPLJay5N9LM"
+)
+
+// testAccFakeServer is the fake API the acceptance tests run against, nil
+// when they run against the Ubicloud API.
+var testAccFakeServer *fakeapi.Server
+
+// TestMain runs the acceptance tests against an in-memory fake of the
+// Ubicloud API if UBICLOUD_ACC_FAKE_API is set, so that they don't need a
+// Ubicloud account. The project the tests run in is created in the fake, and
+// responses of the fake that don't match the OpenAPI spec fail the tests.
+//
+// When replaying cassettes, the API is not called, so a token and the
+// environment of the tests are set to placeholder values unless set, and
+// resources are polled without waiting.
+func TestMain(m *testing.M) {
+	if testAccRecordMode() == replayMode {
+		waitInterval = time.Millisecond
+		for key, value := range map[string]string{
+			"UBICLOUD_API_TOKEN":         "replay-token",
+			"UBICLOUD_ACC_TEST_PROJECT":  "pj000000000000000000000000",
+			"UBICLOUD_ACC_TEST_LOCATION": "eu-central-h1",
+		} {
+			if os.Getenv(key) == "" {
+				os.Setenv(key, value)
+			}
+		}
+	}
+
+	if os.Getenv("UBICLOUD_ACC_FAKE_API") == "" {
+		resource.TestMain(m)
+		return
+	}
+
+	// The fake changes the state of resources on reads, not over time.
+	waitInterval = time.Millisecond
+
+	server := fakeapi.NewServer()
+	location := *fakeapi.Locations[0].Name
+	projectId := server.AddProject("Terraform")
+	testAccFakeServer = server
+
+	for key, value := range map[string]string{
+		"UBICLOUD_API_ENDPOINT":      server.URL,
+		"UBICLOUD_API_TOKEN":         fakeapi.Token,
+		"UBICLOUD_ACC_TEST_PROJECT":  projectId,
+		"UBICLOUD_ACC_TEST_LOCATION": location,
+		strictapi.Env:                "1",
+	} {
+		os.Setenv(key, value)
+	}
+
+	// The server is closed when the test process exits.
+	resource.TestMain(m)
+}
+
 func TestAccPreCheck(t *testing.T) {
 	if v := os.Getenv("UBICLOUD_API_TOKEN"); v == "" {
 		t.Fatal("UBICLOUD_API_TOKEN must be set for acceptance tests")
@@ -39,6 +132,21 @@ func TestAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccFakeAPI skips tests that need the fake API, e.g. to inject errors,
+// and returns it. When replaying cassettes, which hold the responses of the
+// fake the tests were recorded against, it returns an unused fake.
+func testAccFakeAPI(t *testing.T) *fakeapi.Server {
+	if testAccFakeServer == nil && testAccRecordMode() == replayMode {
+		server := fakeapi.NewServer()
+		t.Cleanup(server.Close)
+		return server
+	}
+	if testAccFakeServer == nil {
+		t.Skip("UBICLOUD_ACC_FAKE_API must be set to inject errors in the API")
+	}
+	return testAccFakeServer
+}
+
 func GetTestAccProjectId() string {
 	return os.Getenv("UBICLOUD_ACC_TEST_PROJECT")
 }
@@ -47,19 +155,87 @@ func GetTestAccLocation() string {
 	return os.Getenv("UBICLOUD_ACC_TEST_LOCATION")
 }
 
-func GetTestAccFirewallId() string {
-	return os.Getenv("UBICLOUD_ACC_TEST_FIREWALL")
+// newTestAccClient returns an API client configured from the environment
+// like the provider, sending requests with httpClient unless it is nil.
+func newTestAccClient(httpClient ubicloud_client.HttpRequestDoer) (*ubicloud.Client, error) {
+	endpoint := os.Getenv("UBICLOUD_API_ENDPOINT")
+	if endpoint == "" {
+		endpoint = ubicloud.DefaultEndpoint
+	}
+	return ubicloud.NewClient(os.Getenv("UBICLOUD_API_TOKEN"), ubicloud.WithEndpoint(endpoint), ubicloud.WithHTTPClient(httpClient))
 }
 
-func GetTestAccPrivateSubnetId() string {
-	return os.Getenv("UBICLOUD_ACC_TEST_PRIVATE_SUBNET")
+// testAccCheckDestroy returns a CheckDestroy function confirming through the
+// API that the resources of type resourceType in the state are gone. Its
+// requests are recorded and replayed with those of the provider.
+func testAccCheckDestroy(t *testing.T, resourceType string, exists func(ctx context.Context, client *ubicloud.Client, attrs map[string]string) (bool, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var httpClient ubicloud_client.HttpRequestDoer
+		if r, ok := testAccRecorders.Load(t.Name()); ok {
+			httpClient = r.(*recorder)
+		}
+		client, err := newTestAccClient(httpClient)
+		if err != nil {
+			return err
+		}
+
+		for name, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			found, err := exists(context.Background(), client, rs.Primary.Attributes)
+			if err != nil {
+				return fmt.Errorf("checking destruction of %s: %w", name, err)
+			}
+			if found {
+				return fmt.Errorf("%s still exists", name)
+			}
+		}
+		return nil
+	}
 }
 
-func GetRandomResourceName(resType string) string {
+// testAccExists returns whether the response of reading a resource found
+// it.
+func testAccExists(status int, body []byte) (bool, error) {
+	switch status {
+	case http.StatusNotFound:
+		return false, nil
+	case http.StatusOK:
+		return true, nil
+	default:
+		return false, fmt.Errorf("unexpected HTTP status code %d: %s", status, body)
+	}
+}
+
+var (
+	resourceNameMu    sync.Mutex
+	resourceNameRands = map[string]*rand.Rand{}
+)
+
+// GetRandomResourceName returns a resource name with a random suffix. When
+// recording or replaying cassettes, the names are seeded with the test name,
+// so that a test uses the same names in every run.
+func GetRandomResourceName(t *testing.T, resType string) string {
+	resourceNameMu.Lock()
+	defer resourceNameMu.Unlock()
+
+	r, ok := resourceNameRands[t.Name()]
+	if !ok {
+		seed := time.Now().UnixNano()
+		if testAccRecordMode() != "" {
+			h := fnv.New64a()
+			h.Write([]byte(t.Name()))
+			seed = int64(h.Sum64())
+		}
+		r = rand.New(rand.NewSource(seed))
+		resourceNameRands[t.Name()] = r
+	}
+
 	var letters = []rune("abcdefghijklmnopqrstuvwxyz")
 	b := make([]rune, 8)
 	for i := range b {
-		b[i] = letters[rand.Intn(len(letters))]
+		b[i] = letters[r.Intn(len(letters))]
 	}
 	return fmt.Sprintf("%s-%s-%s", TestAccNamePrefix, resType, string(b))
 }
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
+)
+
+var _ planmodifier.String = publicKeyFingerprintPlanModifier{}
+
+// sshPublicKeyTypes are the key types accepted in public keys. DSA keys and
+// certificates are not supported.
+var sshPublicKeyTypes = []string{
+	ssh.KeyAlgoED25519,
+	ssh.KeyAlgoSKED25519,
+	ssh.KeyAlgoECDSA256,
+	ssh.KeyAlgoECDSA384,
+	ssh.KeyAlgoECDSA521,
+	ssh.KeyAlgoSKECDSA256,
+	ssh.KeyAlgoRSA,
+}
+
+const sshPublicKeyMinRSABits = 2048
+
+// parseSSHPublicKeys parses SSH public keys in the authorized_keys format,
+// one per line. Empty lines and comments are skipped.
+func parseSSHPublicKeys(value string) ([]ssh.PublicKey, error) {
+	var keys []ssh.PublicKey
+	for i, line := range strings.Split(value, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(line))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", i+1, err.Error())
+		}
+
+		if !slices.Contains(sshPublicKeyTypes, key.Type()) {
+			return nil, fmt.Errorf("line %d: key type %s is not supported, use one of %s", i+1, key.Type(), strings.Join(sshPublicKeyTypes, ", "))
+		}
+
+		if cryptoKey, ok := key.(ssh.CryptoPublicKey); ok {
+			if rsaKey, ok := cryptoKey.CryptoPublicKey().(*rsa.PublicKey); ok && rsaKey.N.BitLen() < sshPublicKeyMinRSABits {
+				return nil, fmt.Errorf("line %d: RSA key has %d bits, at least %d are required", i+1, rsaKey.N.BitLen(), sshPublicKeyMinRSABits)
+			}
+		}
+
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no public key found")
+	}
+	return keys, nil
+}
+
+// sshPublicKeyFingerprint returns the SHA256 fingerprints of SSH public keys,
+// in the format printed by ssh-keygen -l, one per line.
+func sshPublicKeyFingerprint(value string) (string, error) {
+	keys, err := parseSSHPublicKeys(value)
+	if err != nil {
+		return "", err
+	}
+
+	fingerprints := make([]string, len(keys))
+	for i, key := range keys {
+		fingerprints[i] = ssh.FingerprintSHA256(key)
+	}
+	return strings.Join(fingerprints, "\n"), nil
+}
+
+// setPublicKeyFingerprint sets the fingerprint of a public key in state. An
+// unknown fingerprint becomes null when there is no valid key to compute it
+// from, as no unknown value may remain after apply.
+func setPublicKeyFingerprint(publicKey types.String, fingerprint *types.String) {
+	if value, err := sshPublicKeyFingerprint(publicKey.ValueString()); err == nil {
+		*fingerprint = types.StringValue(value)
+	} else if fingerprint.IsUnknown() {
+		*fingerprint = types.StringNull()
+	}
+}
+
+// publicKeyFingerprintPlanModifier plans the fingerprint of the public_key
+// attribute, so that it is known before the resource is created.
+type publicKeyFingerprintPlanModifier struct{}
+
+func (m publicKeyFingerprintPlanModifier) Description(_ context.Context) string {
+	return "Sets the value to the SHA256 fingerprint of public_key."
+}
+
+func (m publicKeyFingerprintPlanModifier) MarkdownDescription(_ context.Context) string {
+	return "Sets the value to the SHA256 fingerprint of `public_key`."
+}
+
+func (m publicKeyFingerprintPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var publicKey types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("public_key"), &publicKey)...)
+	if resp.Diagnostics.HasError() || publicKey.IsUnknown() {
+		return
+	}
+
+	if publicKey.IsNull() {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	// Invalid keys are reported by the validator of public_key.
+	if fingerprint, err := sshPublicKeyFingerprint(publicKey.ValueString()); err == nil {
+		resp.PlanValue = types.StringValue(fingerprint)
+	}
+}
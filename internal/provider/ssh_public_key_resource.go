@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/resource_ssh_public_key"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewSshPublicKeyResource() resource.Resource {
+	return &crudResource[sshPublicKeyResourceModel, ubicloud_client.SshPublicKey]{
+		typeName:         "ssh_public_key",
+		noun:             "ssh public key",
+		schema:           sshPublicKeyResourceSchema,
+		importAttributes: []string{"project_id", "id"},
+		identifier:       sshPublicKeyResourceLogIdentifier,
+		create:           createSshPublicKeyResource,
+		read:             readSshPublicKeyResource,
+		update:           updateSshPublicKeyResource,
+		delete:           deleteSshPublicKeyResource,
+		setState:         setSshPublicKeyStateResource,
+	}
+}
+
+// sshPublicKeyResourceModel mirrors resource_ssh_public_key.SshPublicKeyModel
+// and adds the fingerprint of the public key, which is computed by the
+// provider and not part of the API.
+type sshPublicKeyResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	ProjectId            types.String `tfsdk:"project_id"`
+	PublicKey            types.String `tfsdk:"public_key"`
+	PublicKeyFingerprint types.String `tfsdk:"public_key_fingerprint"`
+}
+
+func sshPublicKeyResourceSchema(ctx context.Context) schema.Schema {
+	s := resource_ssh_public_key.SshPublicKeyResourceSchema(ctx)
+	s.Description = "Provides a Ubicloud SshPublicKey resource. This can be used to create, update and delete SSH public keys of a project, " +
+		"which VMs can use through ssh_public_key_id."
+
+	// Name and keys can be changed in place, so that a key is rotated
+	// without editing the VMs using it.
+	requiresReplaceStringAttributes(&s, "project_id")
+	useStateForUnknownStringAttributes(&s, "id")
+	addStringValidators(&s, "public_key", sshPublicKeyValidator{})
+	s.Attributes["public_key_fingerprint"] = schema.StringAttribute{
+		Computed:            true,
+		Description:         "SHA256 fingerprint of the public SSH key, as printed by ssh-keygen -l. One per line if several keys are given",
+		MarkdownDescription: "SHA256 fingerprint of the public SSH key, as printed by `ssh-keygen -l`. One per line if several keys are given",
+		PlanModifiers: []planmodifier.String{
+			publicKeyFingerprintPlanModifier{},
+		},
+	}
+	return s
+}
+
+func createSshPublicKeyResource(ctx context.Context, uc *UbicloudClient, state *sshPublicKeyResourceModel) (*ubicloud_client.SshPublicKey, error) {
+	body := ubicloud_client.CreateSshPublicKeyJSONRequestBody{
+		Name:      state.Name.ValueString(),
+		PublicKey: state.PublicKey.ValueString(),
+	}
+
+	sshPublicKeyResp, err := uc.client.CreateSshPublicKeyWithResponse(ctx, state.ProjectId.ValueString(), body)
+	if err != nil {
+		return nil, err
+	}
+	return sshPublicKeyResp.JSON200, ubicloud.CheckResponse(sshPublicKeyResp, sshPublicKeyResp.Body, http.StatusOK)
+}
+
+func readSshPublicKeyResource(ctx context.Context, uc *UbicloudClient, state *sshPublicKeyResourceModel) (*ubicloud_client.SshPublicKey, error) {
+	sshPublicKeyResp, err := uc.client.GetSshPublicKeyDetailsWithResponse(ctx, state.ProjectId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return sshPublicKeyResp.JSON200, ubicloud.CheckResponse(sshPublicKeyResp, sshPublicKeyResp.Body, http.StatusOK)
+}
+
+func updateSshPublicKeyResource(ctx context.Context, uc *UbicloudClient, plan *sshPublicKeyResourceModel, state *sshPublicKeyResourceModel) (*ubicloud_client.SshPublicKey, error) {
+	body := ubicloud_client.UpdateSshPublicKeyJSONRequestBody{}
+	if !plan.Name.Equal(state.Name) {
+		body.Name = plan.Name.ValueStringPointer()
+	}
+	if !plan.PublicKey.Equal(state.PublicKey) {
+		body.PublicKey = plan.PublicKey.ValueStringPointer()
+	}
+
+	sshPublicKeyResp, err := uc.client.UpdateSshPublicKeyWithResponse(ctx, state.ProjectId.ValueString(), state.Id.ValueString(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.Id = state.Id
+	return sshPublicKeyResp.JSON200, ubicloud.CheckResponse(sshPublicKeyResp, sshPublicKeyResp.Body, http.StatusOK)
+}
+
+func deleteSshPublicKeyResource(ctx context.Context, uc *UbicloudClient, state *sshPublicKeyResourceModel) error {
+	sshPublicKeyResp, err := uc.client.DeleteSshPublicKeyWithResponse(ctx, state.ProjectId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		return err
+	}
+	return ubicloud.CheckResponse(sshPublicKeyResp, sshPublicKeyResp.Body, http.StatusNoContent)
+}
+
+func setSshPublicKeyStateResource(ctx context.Context, sshPublicKey *ubicloud_client.SshPublicKey, state *sshPublicKeyResourceModel) diag.Diagnostics {
+	diags := mapState(ctx, sshPublicKey, state)
+	setPublicKeyFingerprint(state.PublicKey, &state.PublicKeyFingerprint)
+	return diags
+}
+
+// sshPublicKeyResourceLogIdentifier includes the name, as the ID of a key is
+// unknown until it is created.
+func sshPublicKeyResourceLogIdentifier(state *sshPublicKeyResourceModel) string {
+	return fmt.Sprintf("project_id=%s, name=%s, id=%s", state.ProjectId.ValueString(), state.Name.ValueString(), state.Id.ValueString())
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+)
+
+const (
+	testAccRotatedPublicKey            = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAILqk9QpLGULMsmvbyu8r8GT+5sKFZ7C+aAet+AveZ7cz tf-acc-rotated"
+	testAccRotatedPublicKeyFingerprint = "SHA256:2pOTZUFtu5WbTZldVaWmQyNNxCsxBXLAtUQiFoj42Sk"
+)
+
+func TestAccSshPublicKeyResource(t *testing.T) {
+	resName := GetRandomResourceName(t, "key")
+	resourceConfig := func(name string, publicKey string) string {
+		return fmt.Sprintf(`
+    resource "ubicloud_ssh_public_key" "testacc" {
+      project_id = "%s"
+      name       = "%s"
+      public_key = "%s"
+    }`, GetTestAccProjectId(), name, publicKey)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		CheckDestroy:             testAccCheckSshPublicKeyDestroy(t),
+		Steps: []resource.TestStep{
+			// Test Create and Read
+			{
+				Config: providerConfig + resourceConfig(resName, testAccPublicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ubicloud_ssh_public_key.testacc", "id"),
+					resource.TestCheckResourceAttr("ubicloud_ssh_public_key.testacc", "project_id", GetTestAccProjectId()),
+					resource.TestCheckResourceAttr("ubicloud_ssh_public_key.testacc", "name", resName),
+					resource.TestCheckResourceAttr("ubicloud_ssh_public_key.testacc", "public_key", testAccPublicKey),
+					resource.TestCheckResourceAttr("ubicloud_ssh_public_key.testacc", "public_key_fingerprint", testAccPublicKeyFingerprint),
+				),
+			},
+			// Test rename and key rotation in place
+			{
+				Config: providerConfig + resourceConfig(resName+"-rotated", testAccRotatedPublicKey),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ubicloud_ssh_public_key.testacc", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ubicloud_ssh_public_key.testacc", "name", resName+"-rotated"),
+					resource.TestCheckResourceAttr("ubicloud_ssh_public_key.testacc", "public_key", testAccRotatedPublicKey),
+					resource.TestCheckResourceAttr("ubicloud_ssh_public_key.testacc", "public_key_fingerprint", testAccRotatedPublicKeyFingerprint),
+				),
+			},
+			// Test ImportState
+			{
+				ResourceName:      "ubicloud_ssh_public_key.testacc",
+				ImportState:       true,
+				ImportStateIdFunc: sshPublicKeyImportStateIdFunc("ubicloud_ssh_public_key.testacc"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func sshPublicKeyImportStateIdFunc(key string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[key]
+
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", key)
+		}
+
+		if rs.Primary.ID == "" {
+			return "", fmt.Errorf("No Record ID is set")
+		}
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckSshPublicKeyDestroy(t *testing.T) resource.TestCheckFunc {
+	return testAccCheckDestroy(t, "ubicloud_ssh_public_key", func(ctx context.Context, client *ubicloud.Client, attrs map[string]string) (bool, error) {
+		resp, err := client.GetSshPublicKeyDetailsWithResponse(ctx, attrs["project_id"], attrs["id"])
+		if err != nil {
+			return false, err
+		}
+		return testAccExists(resp.StatusCode(), resp.Body)
+	})
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func rsaTestPublicKey(t *testing.T, bits int) string {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := ssh.NewPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(publicKey)))
+}
+
+func TestParseSSHPublicKeys(t *testing.T) {
+	tests := map[string]struct {
+		value string
+		keys  int
+		err   string
+	}{
+		"ed25519":          {value: testAccPublicKey, keys: 1},
+		"without comment":  {value: strings.TrimSuffix(testAccPublicKey, " tf-acc"), keys: 1},
+		"with options":     {value: `from="10.0.0.0/8" ` + testAccPublicKey, keys: 1},
+		"several keys":     {value: testAccPublicKey + "\n\n# team key\n" + rsaTestPublicKey(t, 2048) + "\n", keys: 2},
+		"rsa":              {value: rsaTestPublicKey(t, 2048), keys: 1},
+		"rsa too short":    {value: rsaTestPublicKey(t, 1024), err: "at least 2048"},
+		"unsupported type": {value: "ssh-dss AAAAB3NzaC1kc3MAAACBAP1/U4EddRIpUt9KnC7s5Of2EbdSPO9EAMMeP4C2USZpRV1AIlH7WT2NWPq/xfW6MPbLm1Vs14E7gB00b/JmYLdrmVClpJ+f6AR7ECLCT7up1/63xhv4O1fnxqimFQ8E+4P208UewwI1VBNaFpEy9nXzrith1yrv8iIDGZ3RSAHHAAAAFQCXYFCPFSMLzLKSuYKi64QL8Fgc9QAAAIEA9+GghdabPd7LvKtcNrhXuXmUr7v6OuqC+VdMCz0HgmdRWVeOutRZT+ZxBxCBgLRJFnEj6EwoFhO3zwkyjMim4TwWeotUfI0o4KOuHiuzpnWRbqN/C/ohNWLx+2J6ASQ7zKTxvqhRkImog9/hWuWfBpKLZl6Ae1UlZAFMO/7PSSoAAACAb7p4VBjPWnyGSNgm+FXTzTE2NrjvRjPRmGqzZbwYnDvMUKCtXbwOzqp+TUbvuASDzQyCXhXTTfKKnt1cHJ0LV/ipl+S4dH3V28aXmJuAAuPMeDb/xQmYSh6jfrkUUsbO4Q8b+MKSTl1gvHW5AjWBGqmGMSjs7ra6GAhLpfyiOGY=", err: "not supported"},
+		"not a key":        {value: "the public key", err: "line 1"},
+		"second line":      {value: testAccPublicKey + "\nnot a key", err: "line 2"},
+		"empty":            {value: "", err: "no public key"},
+		"only comments":    {value: "# no keys\n", err: "no public key"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			keys, err := parseSSHPublicKeys(test.value)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("expected error containing %q, got %v", test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(keys) != test.keys {
+				t.Errorf("expected %d keys, got %d", test.keys, len(keys))
+			}
+		})
+	}
+}
+
+func TestSSHPublicKeyFingerprint(t *testing.T) {
+	fingerprint, err := sshPublicKeyFingerprint(testAccPublicKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if fingerprint != testAccPublicKeyFingerprint {
+		t.Errorf("expected %s, got %s", testAccPublicKeyFingerprint, fingerprint)
+	}
+
+	fingerprint, err = sshPublicKeyFingerprint(testAccPublicKey + "\n" + testAccPublicKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := testAccPublicKeyFingerprint + "\n" + testAccPublicKeyFingerprint; fingerprint != expected {
+		t.Errorf("expected %s, got %s", expected, fingerprint)
+	}
+}
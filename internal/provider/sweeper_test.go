@@ -0,0 +1,512 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/fakeapi"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
+)
+
+// Sweepers delete resources with TestAccNamePrefix left behind in the test
+// project by failed acceptance tests. They run with
+//
+//	go test ./internal/provider -v -sweep=<location>
+//
+// where the region of the sweepers is the location to sweep. Dependencies
+// delete VMs, then Postgres databases, then private subnets, then firewalls,
+// each waiting for the deletions to complete.
+func init() {
+	resource.AddTestSweepers("ubicloud_vm", &resource.Sweeper{
+		Name: "ubicloud_vm",
+		F:    sweepVms,
+	})
+	resource.AddTestSweepers("ubicloud_postgres", &resource.Sweeper{
+		Name:         "ubicloud_postgres",
+		Dependencies: []string{"ubicloud_vm"},
+		F:            sweepPostgres,
+	})
+	resource.AddTestSweepers("ubicloud_private_subnet", &resource.Sweeper{
+		Name:         "ubicloud_private_subnet",
+		Dependencies: []string{"ubicloud_vm", "ubicloud_postgres"},
+		F:            sweepPrivateSubnets,
+	})
+	resource.AddTestSweepers("ubicloud_firewall", &resource.Sweeper{
+		Name:         "ubicloud_firewall",
+		Dependencies: []string{"ubicloud_private_subnet"},
+		F:            sweepFirewalls,
+	})
+	resource.AddTestSweepers("ubicloud_ssh_public_key", &resource.Sweeper{
+		Name:         "ubicloud_ssh_public_key",
+		Dependencies: []string{"ubicloud_vm"},
+		F:            sweepSshPublicKeys,
+	})
+	resource.AddTestSweepers("ubicloud_project", &resource.Sweeper{
+		Name:         "ubicloud_project",
+		Dependencies: []string{"ubicloud_firewall", "ubicloud_ssh_public_key"},
+		F:            sweepProjects,
+	})
+}
+
+var (
+	// sweepPollInterval is the interval at which sweepers check whether a
+	// deleted resource is gone, and sweepTimeout how long they wait for it.
+	sweepPollInterval = 10 * time.Second
+	sweepTimeout      = 20 * time.Minute
+	sweepPageSize     = 100
+)
+
+// sweepClient returns an API client configured from the environment like
+// the provider, and the test project to sweep.
+func sweepClient() (*ubicloud.Client, string, error) {
+	projectId := GetTestAccProjectId()
+	if projectId == "" {
+		return nil, "", fmt.Errorf("UBICLOUD_ACC_TEST_PROJECT must be set for sweepers")
+	}
+
+	client, err := newTestAccClient(nil)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, projectId, nil
+}
+
+func isSweepable(name *string) bool {
+	return name != nil && strings.HasPrefix(*name, TestAccNamePrefix)
+}
+
+// checkSweepResponse returns an error for a response other than the
+// expected status or not found.
+func checkSweepResponse(desc string, status int, body []byte, expected int) error {
+	if status != expected && status != http.StatusNotFound {
+		return fmt.Errorf("unexpected HTTP status code %d %s: %s", status, desc, body)
+	}
+	return nil
+}
+
+// waitForSweep waits until get reports a deleted resource as not found.
+func waitForSweep(ctx context.Context, desc string, get func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, sweepTimeout)
+	defer cancel()
+
+	if err := ubicloud.WaitForDeletion(ctx, sweepPollInterval, get); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %w", desc, err)
+	}
+	return nil
+}
+
+func sweepVms(location string) error {
+	ctx := context.Background()
+	client, projectId, err := sweepClient()
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for vm, err := range client.LocationVMs(ctx, projectId, location, ubicloud.WithPageSize(sweepPageSize)) {
+		if err != nil {
+			return fmt.Errorf("listing VMs: %w", err)
+		}
+		if isSweepable(vm.Name) {
+			names = append(names, *vm.Name)
+		}
+	}
+
+	for _, name := range names {
+		log.Printf("[INFO] Deleting VM %s in %s", name, location)
+		resp, err := client.DeleteVMWithResponse(ctx, projectId, location, name)
+		if err != nil {
+			return err
+		}
+		if err := checkSweepResponse("deleting VM "+name, resp.StatusCode(), resp.Body, http.StatusNoContent); err != nil {
+			return err
+		}
+	}
+	for _, name := range names {
+		err := waitForSweep(ctx, "VM "+name, func(ctx context.Context) error {
+			resp, err := client.GetVMDetailsWithResponse(ctx, projectId, location, name)
+			if err != nil {
+				return err
+			}
+			return ubicloud.CheckResponse(resp, resp.Body, http.StatusOK)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sweepPostgres(location string) error {
+	ctx := context.Background()
+	client, projectId, err := sweepClient()
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for pg, err := range client.LocationPostgresDatabases(ctx, projectId, location, ubicloud.WithPageSize(sweepPageSize)) {
+		if err != nil {
+			return fmt.Errorf("listing postgres databases: %w", err)
+		}
+		if isSweepable(pg.Name) {
+			names = append(names, *pg.Name)
+		}
+	}
+
+	for _, name := range names {
+		log.Printf("[INFO] Deleting postgres database %s in %s", name, location)
+		resp, err := client.DeletePostgresDatabaseWithResponse(ctx, projectId, location, name)
+		if err != nil {
+			return err
+		}
+		if err := checkSweepResponse("deleting postgres database "+name, resp.StatusCode(), resp.Body, http.StatusNoContent); err != nil {
+			return err
+		}
+	}
+	for _, name := range names {
+		err := waitForSweep(ctx, "postgres database "+name, func(ctx context.Context) error {
+			resp, err := client.GetPostgresDatabaseDetailsWithResponse(ctx, projectId, location, name)
+			if err != nil {
+				return err
+			}
+			return ubicloud.CheckResponse(resp, resp.Body, http.StatusOK)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listPrivateSubnets returns the private subnets of the test project in
+// location.
+func listPrivateSubnets(ctx context.Context, client *ubicloud.Client, projectId string, location string) ([]ubicloud_client.PrivateSubnet, error) {
+	var subnets []ubicloud_client.PrivateSubnet
+	for ps, err := range client.LocationPrivateSubnets(ctx, projectId, location, ubicloud.WithPageSize(sweepPageSize)) {
+		if err != nil {
+			return nil, fmt.Errorf("listing private subnets: %w", err)
+		}
+		subnets = append(subnets, ps)
+	}
+	return subnets, nil
+}
+
+func sweepPrivateSubnets(location string) error {
+	ctx := context.Background()
+	client, projectId, err := sweepClient()
+	if err != nil {
+		return err
+	}
+
+	subnets, err := listPrivateSubnets(ctx, client, projectId, location)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for _, ps := range subnets {
+		if !isSweepable(ps.Name) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting private subnet %s in %s", *ps.Name, location)
+		resp, err := client.DeletePrivateSubnetWithResponse(ctx, projectId, location, *ps.Name)
+		if err != nil {
+			return err
+		}
+		if err := checkSweepResponse("deleting private subnet "+*ps.Name, resp.StatusCode(), resp.Body, http.StatusNoContent); err != nil {
+			return err
+		}
+		names = append(names, *ps.Name)
+	}
+
+	for _, name := range names {
+		err := waitForSweep(ctx, "private subnet "+name, func(ctx context.Context) error {
+			resp, err := client.GetPrivateSubnetDetailsWithResponse(ctx, projectId, location, name)
+			if err != nil {
+				return err
+			}
+			return ubicloud.CheckResponse(resp, resp.Body, http.StatusOK)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sweepFirewalls(location string) error {
+	ctx := context.Background()
+	client, projectId, err := sweepClient()
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for fw, err := range client.LocationFirewalls(ctx, projectId, location, ubicloud.WithPageSize(sweepPageSize)) {
+		if err != nil {
+			return fmt.Errorf("listing firewalls: %w", err)
+		}
+		if isSweepable(fw.Name) {
+			names = append(names, *fw.Name)
+		}
+	}
+
+	for _, name := range names {
+		log.Printf("[INFO] Deleting firewall %s in %s", name, location)
+		resp, err := client.DeleteFirewallWithResponse(ctx, projectId, location, name)
+		if err != nil {
+			return err
+		}
+		if err := checkSweepResponse("deleting firewall "+name, resp.StatusCode(), resp.Body, http.StatusNoContent); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range names {
+		err := waitForSweep(ctx, "firewall "+name, func(ctx context.Context) error {
+			resp, err := client.GetFirewallDetailsWithResponse(ctx, projectId, location, name)
+			if err != nil {
+				return err
+			}
+			return ubicloud.CheckResponse(resp, resp.Body, http.StatusOK)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sweepSshPublicKeys deletes the SSH public keys of the test project. They
+// don't belong to a location, so they are swept in every region.
+func sweepSshPublicKeys(string) error {
+	ctx := context.Background()
+	client, projectId, err := sweepClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListSshPublicKeysWithResponse(ctx, projectId)
+	if err != nil {
+		return err
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil {
+		return fmt.Errorf("unexpected HTTP status code %d listing SSH public keys: %s", resp.StatusCode(), resp.Body)
+	}
+
+	for _, key := range *resp.JSON200.Items {
+		if !isSweepable(key.Name) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting SSH public key %s", *key.Name)
+		deleteResp, err := client.DeleteSshPublicKeyWithResponse(ctx, projectId, *key.Id)
+		if err != nil {
+			return err
+		}
+		if err := checkSweepResponse("deleting SSH public key "+*key.Name, deleteResp.StatusCode(), deleteResp.Body, http.StatusNoContent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sweepProjectsEnv must be set to 1 for the project sweeper to run, as
+// projects are not scoped to the test project, but to the whole account of
+// the token.
+const sweepProjectsEnv = "UBICLOUD_ACC_SWEEP_PROJECTS"
+
+// sweepProjectPrefix starts the names of the projects created by the project
+// acceptance tests, as given by GetRandomResourceName.
+const sweepProjectPrefix = TestAccNamePrefix + "-project-"
+
+// sweepProjects deletes the projects created by the project acceptance
+// tests, if enabled with sweepProjectsEnv. They don't belong to a location,
+// so they are swept in every region.
+func sweepProjects(string) error {
+	if os.Getenv(sweepProjectsEnv) != "1" {
+		log.Printf("[INFO] Skipping the project sweeper, set %s=1 to run it", sweepProjectsEnv)
+		return nil
+	}
+
+	ctx := context.Background()
+	client, projectId, err := sweepClient()
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for project, err := range client.Projects(ctx, ubicloud.WithPageSize(sweepPageSize)) {
+		if err != nil {
+			return fmt.Errorf("listing projects: %w", err)
+		}
+		if project.Name != nil && strings.HasPrefix(*project.Name, sweepProjectPrefix) && *project.Id != projectId {
+			ids = append(ids, *project.Id)
+		}
+	}
+
+	for _, id := range ids {
+		log.Printf("[INFO] Deleting project %s", id)
+		resp, err := client.DeleteProjectWithResponse(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := checkSweepResponse("deleting project "+id, resp.StatusCode(), resp.Body, http.StatusNoContent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestSweepers(t *testing.T) {
+	server := fakeapi.NewServer()
+	t.Cleanup(server.Close)
+	server.SetTransitionReads(2)
+
+	location := *fakeapi.Locations[0].Name
+	projectId := server.AddProject("Terraform")
+	server.AddPrivateSubnet(projectId, location, "keep-subnet", server.AddFirewall(projectId, location, "tf-acc-firewall"))
+	server.AddFirewall(projectId, location, "tf-acc-unattached")
+	server.AddFirewall(projectId, location, "keep-firewall")
+
+	t.Setenv("UBICLOUD_API_ENDPOINT", server.URL)
+	t.Setenv("UBICLOUD_API_TOKEN", fakeapi.Token)
+	t.Setenv("UBICLOUD_ACC_TEST_PROJECT", projectId)
+
+	pollInterval := sweepPollInterval
+	sweepPollInterval = time.Millisecond
+	t.Cleanup(func() {
+		sweepPollInterval = pollInterval
+	})
+
+	ctx := context.Background()
+	client, _, err := sweepClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	create := func(desc string, status int, body []byte, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status != http.StatusOK {
+			t.Fatalf("unexpected HTTP status code %d creating %s: %s", status, desc, body)
+		}
+	}
+
+	publicKey := testAccPublicKey
+	for _, name := range []string{"tf-acc-ps", "keep-ps"} {
+		resp, err := client.CreatePrivateSubnetWithResponse(ctx, projectId, location, name, ubicloud_client.CreatePrivateSubnetJSONRequestBody{})
+		create(name, resp.StatusCode(), resp.Body, err)
+		if name == "tf-acc-ps" {
+			vmResp, err := client.CreateVMWithResponse(ctx, projectId, location, "tf-acc-vm", ubicloud_client.CreateVMJSONRequestBody{
+				PublicKey:       &publicKey,
+				PrivateSubnetId: resp.JSON200.Id,
+			})
+			create("tf-acc-vm", vmResp.StatusCode(), vmResp.Body, err)
+		}
+	}
+	for _, name := range []string{"tf-acc-pg", "keep-pg"} {
+		resp, err := client.CreatePostgresDatabaseWithResponse(ctx, projectId, location, name, ubicloud_client.CreatePostgresDatabaseJSONRequestBody{Size: "standard-2"})
+		create(name, resp.StatusCode(), resp.Body, err)
+	}
+	for _, name := range []string{"tf-acc-key", "keep-key"} {
+		resp, err := client.CreateSshPublicKeyWithResponse(ctx, projectId, ubicloud_client.CreateSshPublicKeyJSONRequestBody{Name: name, PublicKey: testAccPublicKey})
+		create(name, resp.StatusCode(), resp.Body, err)
+	}
+	for _, name := range []string{"tf-acc-project-abcdefgh", "tf-acc-other"} {
+		resp, err := client.CreateProjectWithResponse(ctx, ubicloud_client.CreateProjectJSONRequestBody{Name: name})
+		create(name, resp.StatusCode(), resp.Body, err)
+	}
+
+	// The project sweeper does nothing unless enabled.
+	if err := sweepProjects(location); err != nil {
+		t.Fatal(err)
+	}
+	projects, err := client.ListProjectsWithResponse(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(*projects.JSON200.Items); got != 3 {
+		t.Errorf("expected no project swept without %s, got %d projects", sweepProjectsEnv, got)
+	}
+	t.Setenv(sweepProjectsEnv, "1")
+
+	for _, sweep := range []resource.SweeperFunc{sweepVms, sweepPostgres, sweepPrivateSubnets, sweepFirewalls, sweepSshPublicKeys, sweepProjects} {
+		if err := sweep(location); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	list := func(desc string, status int, body []byte, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status != http.StatusOK {
+			t.Fatalf("unexpected HTTP status code %d listing %s: %s", status, desc, body)
+		}
+	}
+
+	vms, err := client.ListLocationVMsWithResponse(ctx, projectId, location, nil)
+	list("VMs", vms.StatusCode(), vms.Body, err)
+	if got := sweptNames(*vms.JSON200.Items, func(vm ubicloud_client.Vm) *string { return vm.Name }); len(got) != 0 {
+		t.Errorf("unexpected VMs after sweeping: %v", got)
+	}
+
+	pgs, err := client.ListLocationPostgresDatabasesWithResponse(ctx, projectId, location, nil)
+	list("postgres databases", pgs.StatusCode(), pgs.Body, err)
+	if got := sweptNames(*pgs.JSON200.Items, func(pg ubicloud_client.Postgres) *string { return pg.Name }); !slices.Equal(got, []string{"keep-pg"}) {
+		t.Errorf("unexpected postgres databases after sweeping: %v", got)
+	}
+
+	subnets, err := client.ListLocationPrivateSubnetsWithResponse(ctx, projectId, location, nil)
+	list("private subnets", subnets.StatusCode(), subnets.Body, err)
+	if got := sweptNames(*subnets.JSON200.Items, func(ps ubicloud_client.PrivateSubnet) *string { return ps.Name }); !slices.Equal(got, []string{"keep-ps", "keep-subnet"}) {
+		t.Errorf("unexpected private subnets after sweeping: %v", got)
+	}
+
+	for name, expected := range map[string]int{"tf-acc-firewall": http.StatusNotFound, "tf-acc-unattached": http.StatusNotFound, "keep-firewall": http.StatusOK, "keep-ps-default": http.StatusOK, "tf-acc-ps-default": http.StatusNotFound} {
+		resp, err := client.GetFirewallDetailsWithResponse(ctx, projectId, location, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != expected {
+			t.Errorf("expected HTTP status code %d for firewall %s after sweeping, got %d", expected, name, resp.StatusCode())
+		}
+	}
+
+	keys, err := client.ListSshPublicKeysWithResponse(ctx, projectId)
+	list("SSH public keys", keys.StatusCode(), keys.Body, err)
+	if got := sweptNames(*keys.JSON200.Items, func(key ubicloud_client.SshPublicKey) *string { return key.Name }); !slices.Equal(got, []string{"keep-key"}) {
+		t.Errorf("unexpected SSH public keys after sweeping: %v", got)
+	}
+
+	projects, err = client.ListProjectsWithResponse(ctx, nil)
+	list("projects", projects.StatusCode(), projects.Body, err)
+	if got := sweptNames(*projects.JSON200.Items, func(project ubicloud_client.Project) *string { return project.Name }); !slices.Equal(got, []string{"Terraform", "tf-acc-other"}) {
+		t.Errorf("unexpected projects after sweeping: %v", got)
+	}
+}
+
+// sweptNames returns the sorted names of listed resources.
+func sweptNames[T any](items []T, name func(T) *string) []string {
+	var names []string
+	for _, item := range items {
+		names = append(names, *name(item))
+	}
+	slices.Sort(names)
+	return names
+}
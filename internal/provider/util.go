@@ -1,6 +1,13 @@
 package provider
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -11,20 +18,50 @@ func assignStr(source *string, target *basetypes.StringValue) {
 	}
 }
 
-func assignInt(source *int, target *basetypes.Int64Value) {
-	if source != nil {
-		*target = types.Int64Value(int64(*source))
+// addStringPlanModifiers appends plan modifiers to string attributes of a
+// generated resource schema. Attributes of other types are left untouched.
+func addStringPlanModifiers(s *schema.Schema, modifier planmodifier.String, names ...string) {
+	for _, name := range names {
+		if attr, ok := s.Attributes[name].(schema.StringAttribute); ok {
+			attr.PlanModifiers = append(attr.PlanModifiers, modifier)
+			s.Attributes[name] = attr
+		}
 	}
 }
 
-func assignFloat(source *float32, target *basetypes.Float64Value) {
-	if source != nil {
-		*target = types.Float64Value(float64(*source))
+func requiresReplaceStringAttributes(s *schema.Schema, names ...string) {
+	addStringPlanModifiers(s, stringplanmodifier.RequiresReplace(), names...)
+}
+
+func useStateForUnknownStringAttributes(s *schema.Schema, names ...string) {
+	addStringPlanModifiers(s, stringplanmodifier.UseStateForUnknown(), names...)
+}
+
+// addStringValidators appends validators to a string attribute of a
+// generated resource schema.
+func addStringValidators(s *schema.Schema, name string, validators ...validator.String) {
+	if attr, ok := s.Attributes[name].(schema.StringAttribute); ok {
+		attr.Validators = append(attr.Validators, validators...)
+		s.Attributes[name] = attr
 	}
 }
 
-func assignBool(source *bool, target *basetypes.BoolValue) {
-	if source != nil {
-		*target = types.BoolValue(bool(*source))
+// setStringCustomType sets the custom type of a string attribute of a
+// generated resource schema. The model used with the schema must declare the
+// attribute with the matching value type.
+func setStringCustomType(s *schema.Schema, name string, customType basetypes.StringTypable) {
+	if attr, ok := s.Attributes[name].(schema.StringAttribute); ok {
+		attr.CustomType = customType
+		s.Attributes[name] = attr
+	}
+}
+
+// addTimeoutsBlock adds the timeouts block bounding the waits of a
+// crudResource to a generated resource schema. The model used with the schema
+// must declare it as a timeouts.Value.
+func addTimeoutsBlock(ctx context.Context, s *schema.Schema, opts timeouts.Opts) {
+	if s.Blocks == nil {
+		s.Blocks = map[string]schema.Block{}
 	}
+	s.Blocks["timeouts"] = timeouts.Block(ctx, opts)
 }
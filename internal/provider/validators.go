@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String = cidrValidator{}
+	_ validator.String = portRangeValidator{}
+	_ validator.String = catalogValidator{}
+	_ validator.String = sshPublicKeyValidator{}
+)
+
+// cidrValidator checks that a string is an IPv4 or IPv6 CIDR block. Blocks
+// with host bits set, or not written the way the API returns them, e.g. with
+// upper case IPv6 digits, produce a warning.
+type cidrValidator struct{}
+
+func (v cidrValidator) Description(_ context.Context) string {
+	return "value must be an IPv4 or IPv6 CIDR block, e.g. 10.0.0.0/8 or 2001:db8::/32"
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("Attribute %s %s, got: %q. %s", req.Path, v.Description(ctx), value, err.Error()),
+		)
+		return
+	}
+
+	if masked := prefix.Masked(); masked != prefix {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"CIDR Has Host Bits Set",
+			fmt.Sprintf("Attribute %s has host bits set in %q, did you mean %q?", req.Path, value, masked.String()),
+		)
+		return
+	}
+
+	if canonical := prefix.String(); canonical != value {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Non-canonical CIDR",
+			fmt.Sprintf("Attribute %s value %q is valid, but is written as %q by the API.", req.Path, value, canonical),
+		)
+	}
+}
+
+// portRangeValidator checks that a string is a port range in the N..M or
+// N-M format, or a single port, with ports between 0 and 65535 and N <= M.
+// Valid ranges not in the N..M format produce a warning.
+type portRangeValidator struct{}
+
+func (v portRangeValidator) Description(_ context.Context) string {
+	return "value must be a port range in the format N..M, e.g. 22..22 or 8000..8080, with ports between 0 and 65535"
+}
+
+func (v portRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v portRangeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	first, last, err := parsePortRange(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Port Range",
+			fmt.Sprintf("Attribute %s %s, got: %q. %s", req.Path, v.Description(ctx), value, err.Error()),
+		)
+		return
+	}
+
+	if canonical := formatPortRange(first, last); canonical != value {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Non-canonical Port Range",
+			fmt.Sprintf("Attribute %s value %q is valid, but is written as %q by the API.", req.Path, value, canonical),
+		)
+	}
+}
+
+// parsePortRange parses a port range in the N..M or N-M format, or a single
+// port N, and returns its first and last port.
+func parsePortRange(value string) (int, int, error) {
+	var parts []string
+	switch {
+	case strings.Contains(value, ".."):
+		parts = strings.Split(value, "..")
+	case strings.Contains(value, "-"):
+		parts = strings.Split(value, "-")
+	default:
+		parts = []string{value, value}
+	}
+
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("expected exactly two ports")
+	}
+
+	ports := make([]int, 2)
+	for i, part := range parts {
+		port, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return 0, 0, fmt.Errorf("%q is not a port number", part)
+		}
+		if port < 0 || port > 65535 {
+			return 0, 0, fmt.Errorf("port %d is out of range", port)
+		}
+		ports[i] = port
+	}
+
+	if ports[0] > ports[1] {
+		return 0, 0, fmt.Errorf("first port %d is greater than last port %d", ports[0], ports[1])
+	}
+
+	return ports[0], ports[1], nil
+}
+
+func formatPortRange(first int, last int) string {
+	return fmt.Sprintf("%d..%d", first, last)
+}
+
+// sshPublicKeyValidator checks that a string holds SSH public keys in the
+// authorized_keys format, one per line, of a supported type and length.
+type sshPublicKeyValidator struct{}
+
+func (v sshPublicKeyValidator) Description(_ context.Context) string {
+	return "value must be SSH public keys in the authorized_keys format, one per line, e.g. ssh-ed25519 AAAA... user@host"
+}
+
+func (v sshPublicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sshPublicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseSSHPublicKeys(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SSH Public Key",
+			fmt.Sprintf("Attribute %s %s. %s", req.Path, v.Description(ctx), err.Error()),
+		)
+	}
+}
+
+// catalogValidator checks that a string is one of the values of the catalog,
+// suggesting the closest values otherwise. Unknown values are only warned
+// about when the catalog is the static fallback, which may be out of date.
+type catalogValidator struct {
+	kind   string
+	values []string
+	static bool
+}
+
+func (v catalogValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a known %s: %s", v.kind, strings.Join(v.values, ", "))
+}
+
+func (v catalogValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v catalogValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || len(v.values) == 0 {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if slices.Contains(v.values, value) {
+		return
+	}
+
+	detail := fmt.Sprintf("Attribute %s value %q is not a known %s.", req.Path, value, v.kind)
+	if suggestions := closestValues(value, v.values); len(suggestions) > 0 {
+		detail += fmt.Sprintf(" Did you mean %s?", strings.Join(quoteAll(suggestions), " or "))
+	}
+	detail += fmt.Sprintf(" Known values: %s.", strings.Join(v.values, ", "))
+
+	if v.static {
+		resp.Diagnostics.AddAttributeWarning(req.Path, fmt.Sprintf("Unknown %s", v.kind), detail+" The list of known values may be out of date.")
+		return
+	}
+	resp.Diagnostics.AddAttributeError(req.Path, fmt.Sprintf("Invalid %s", v.kind), detail)
+}
+
+// closestValues returns the values with the smallest edit distance to value,
+// as long as the distance is small enough for them to be likely typos.
+func closestValues(value string, values []string) []string {
+	maxDistance := max(2, len(value)/3)
+	best := maxDistance + 1
+	var closest []string
+	for _, candidate := range values {
+		distance := editDistance(strings.ToLower(value), strings.ToLower(candidate))
+		switch {
+		case distance < best:
+			best = distance
+			closest = []string{candidate}
+		case distance == best:
+			closest = append(closest, candidate)
+		}
+	}
+	return closest
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a string, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+func quoteAll(values []string) []string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+	return quoted
+}
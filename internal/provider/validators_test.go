@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type stringValidatorTestCase struct {
+	value   types.String
+	errors  int
+	warning bool
+}
+
+func runStringValidatorTests(t *testing.T, v validator.String, tests map[string]stringValidatorTestCase) {
+	t.Helper()
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: test.value,
+			}
+			resp := &validator.StringResponse{}
+			v.ValidateString(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != test.errors {
+				t.Errorf("expected %d errors, got %d: %v", test.errors, got, resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != test.warning {
+				t.Errorf("expected warning=%t, got %v", test.warning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCidrValidator(t *testing.T) {
+	runStringValidatorTests(t, cidrValidator{}, map[string]stringValidatorTestCase{
+		"null":                 {value: types.StringNull()},
+		"unknown":              {value: types.StringUnknown()},
+		"ipv4":                 {value: types.StringValue("10.0.0.0/8")},
+		"ipv4 any":             {value: types.StringValue("0.0.0.0/0")},
+		"ipv4 host":            {value: types.StringValue("192.168.1.10/32")},
+		"ipv6":                 {value: types.StringValue("2001:db8::/32")},
+		"ipv6 any":             {value: types.StringValue("::/0")},
+		"ipv4 prefix too long": {value: types.StringValue("10.0.0.0/33"), errors: 1},
+		"ipv6 prefix too long": {value: types.StringValue("2001:db8::/129"), errors: 1},
+		"ipv4 host bits":       {value: types.StringValue("10.0.0.5/24"), warning: true},
+		"ipv6 host bits":       {value: types.StringValue("2001:db8::1/64"), warning: true},
+		"missing prefix":       {value: types.StringValue("10.0.0.0"), errors: 1},
+		"garbage":              {value: types.StringValue("not-a-cidr"), errors: 1},
+		"empty":                {value: types.StringValue(""), errors: 1},
+		"ipv6 upper case":      {value: types.StringValue("2001:DB8::/32"), warning: true},
+		"ipv6 leading zeros":   {value: types.StringValue("2001:0db8::/32"), warning: true},
+	})
+}
+
+func TestPortRangeValidator(t *testing.T) {
+	runStringValidatorTests(t, portRangeValidator{}, map[string]stringValidatorTestCase{
+		"null":             {value: types.StringNull()},
+		"unknown":          {value: types.StringUnknown()},
+		"single port":      {value: types.StringValue("22..22")},
+		"range":            {value: types.StringValue("8000..8080")},
+		"all ports":        {value: types.StringValue("0..65535")},
+		"dash":             {value: types.StringValue("80-443"), warning: true},
+		"port only":        {value: types.StringValue("22"), warning: true},
+		"leading zero":     {value: types.StringValue("022..22"), warning: true},
+		"reversed":         {value: types.StringValue("80..22"), errors: 1},
+		"reversed dash":    {value: types.StringValue("80-22"), errors: 1},
+		"out of range":     {value: types.StringValue("0..65536"), errors: 1},
+		"negative":         {value: types.StringValue("-1..22"), errors: 1},
+		"three parts":      {value: types.StringValue("1..2..3"), errors: 1},
+		"missing last":     {value: types.StringValue("22.."), errors: 1},
+		"not a number":     {value: types.StringValue("ssh"), errors: 1},
+		"empty":            {value: types.StringValue(""), errors: 1},
+		"mixed separators": {value: types.StringValue("1-2..3"), errors: 1},
+	})
+}
+
+func TestCatalogValidator(t *testing.T) {
+	sizes := []string{"standard-2", "standard-4", "standard-8"}
+	runStringValidatorTests(t, catalogValidator{kind: "VM size", values: sizes}, map[string]stringValidatorTestCase{
+		"null":    {value: types.StringNull()},
+		"unknown": {value: types.StringUnknown()},
+		"known":   {value: types.StringValue("standard-4")},
+		"typo":    {value: types.StringValue("standard-3"), errors: 1},
+		"garbage": {value: types.StringValue("huge"), errors: 1},
+	})
+	runStringValidatorTests(t, catalogValidator{kind: "VM size", values: sizes, static: true}, map[string]stringValidatorTestCase{
+		"static known": {value: types.StringValue("standard-4")},
+		"static typo":  {value: types.StringValue("standard-3"), warning: true},
+	})
+	runStringValidatorTests(t, catalogValidator{kind: "VM size"}, map[string]stringValidatorTestCase{
+		"empty catalog": {value: types.StringValue("standard-3")},
+	})
+}
+
+func TestClosestValues(t *testing.T) {
+	values := []string{"standard-2", "standard-4", "standard-8", "eu-central-h1", "eu-north-h1"}
+	tests := map[string][]string{
+		"standard-3":   {"standard-2", "standard-4", "standard-8"},
+		"standard-88":  {"standard-8"},
+		"Standard-2":   {"standard-2"},
+		"eu-centrl-h1": {"eu-central-h1"},
+		"us-west-1":    nil,
+	}
+
+	for value, expected := range tests {
+		t.Run(value, func(t *testing.T) {
+			if got := closestValues(value, values); !slices.Equal(got, expected) {
+				t.Errorf("expected %v, got %v", expected, got)
+			}
+		})
+	}
+}
+
+func TestSSHPublicKeyValidator(t *testing.T) {
+	runStringValidatorTests(t, sshPublicKeyValidator{}, map[string]stringValidatorTestCase{
+		"null":      {value: types.StringNull()},
+		"unknown":   {value: types.StringUnknown()},
+		"valid":     {value: types.StringValue(testAccPublicKey)},
+		"not a key": {value: types.StringValue("the public key"), errors: 1},
+		"empty":     {value: types.StringValue(""), errors: 1},
+	})
+}
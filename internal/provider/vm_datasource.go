@@ -6,7 +6,7 @@ import (
 	"net/http"
 
 	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/datasource_vm"
-	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_client"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -88,26 +88,7 @@ func (d *vmDataSource) Read(ctx context.Context, req datasource.ReadRequest, res
 }
 
 func setVmStateDatasource(ctx context.Context, vmd *ubicloud_client.VmDetailed, state *datasource_vm.VmModel) diag.Diagnostics {
-	assignStr(vmd.Id, &state.Id)
-	assignStr(vmd.Name, &state.Name)
-	assignStr(vmd.State, &state.State)
-	assignStr(vmd.Location, &state.Location)
-	assignStr(vmd.Size, &state.Size)
-	assignStr(vmd.UnixUser, &state.UnixUser)
-	assignInt(vmd.StorageSizeGib, &state.StorageSizeGib)
-	assignStr(vmd.Ip4, &state.Ip4)
-	assignStr(vmd.Ip6, &state.Ip6)
-	assignStr(vmd.PrivateIpv4, &state.PrivateIpv4)
-	assignStr(vmd.PrivateIpv6, &state.PrivateIpv6)
-	assignStr(vmd.Subnet, &state.Subnet)
-
-	firewallsListValue, diags := GetFirewallsState(ctx, vmd.Firewalls)
-	if diags.HasError() {
-		return diags
-	}
-
-	state.Firewalls = firewallsListValue
-	return diags
+	return mapState(ctx, vmd, state)
 }
 
 func vmDataSourceLogIdentifier(state *datasource_vm.VmModel) string {
@@ -8,27 +8,28 @@ import (
 )
 
 func TestAccVmDataSource(t *testing.T) {
-	resName := GetRandomResourceName("vm")
+	resName := GetRandomResourceName(t, "vm")
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
 		PreCheck:                 func() { TestAccPreCheck(t) },
+		CheckDestroy:             testAccCheckVmSubnetDestroy(t),
 		Steps: []resource.TestStep{
 			{
-				Config: providerConfig +
+				Config: providerConfig + testAccPrivateSubnetConfig(GetRandomResourceName(t, "sn"), GetRandomResourceName(t, "fw")) +
 					fmt.Sprintf(`
         resource "ubicloud_vm" "testacc" {
           project_id  			= "%s"
           location    			= "%s"
-          private_subnet_id	= "%s"
+          private_subnet_id	= ubicloud_private_subnet.testacc.id
           name        		  = "%s"
-          public_key  			= "the public key"
+          public_key  			= "%s"
         }
         
         data "ubicloud_vm" "testacc" {
           project_id = ubicloud_vm.testacc.project_id
           location = ubicloud_vm.testacc.location
           name = ubicloud_vm.testacc.name
-        }`, GetTestAccProjectId(), GetTestAccLocation(), GetTestAccPrivateSubnetId(), resName),
+        }`, GetTestAccProjectId(), GetTestAccLocation(), resName, testAccPublicKey),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.ubicloud_vm.testacc", "id"),
 					resource.TestCheckResourceAttr("data.ubicloud_vm.testacc", "project_id", GetTestAccProjectId()),
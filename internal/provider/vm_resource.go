@@ -4,70 +4,130 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/resource_vm"
-	"github.com/ubicloud/terraform-provider-ubicloud/internal/generated/ubicloud_client"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var (
-	_ resource.Resource                = &vmResource{}
-	_ resource.ResourceWithConfigure   = &vmResource{}
-	_ resource.ResourceWithImportState = &vmResource{}
-)
+var _ resource.ResourceWithValidateConfig = &vmResource{}
 
 func NewVmResource() resource.Resource {
-	return &vmResource{}
+	return &vmResource{crudResource[vmResourceModel, ubicloud_client.VmDetailed]{
+		typeName:         "vm",
+		noun:             "vm",
+		schema:           vmResourceSchema,
+		importAttributes: []string{"project_id", "location", "name"},
+		identifier:       vmResourceLogIdentifier,
+		create:           createVmResource,
+		read:             readVmResource,
+		delete:           deleteVmResource,
+		setState:         setVmStateResource,
+		waitReady:        waitVmResourceRunning,
+		waitDeleted:      waitNotFound(readVmResource),
+	}}
 }
 
+// vmResource adds the validation of the configuration against the catalog
+// of the API.
 type vmResource struct {
-	uc *UbicloudClient
+	crudResource[vmResourceModel, ubicloud_client.VmDetailed]
 }
 
-func (r *vmResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
+// vmResourceModel mirrors resource_vm.VmModel and adds the fingerprint of the
+// public key, which is computed by the provider and not part of the API, and
+// the timeouts block.
+type vmResourceModel struct {
+	BootImage            types.String   `tfsdk:"boot_image"`
+	EnableIp4            types.Bool     `tfsdk:"enable_ip4"`
+	Firewalls            types.List     `tfsdk:"firewalls"`
+	Id                   types.String   `tfsdk:"id"`
+	Ip4                  types.String   `tfsdk:"ip4"`
+	Ip6                  types.String   `tfsdk:"ip6"`
+	Location             types.String   `tfsdk:"location"`
+	Name                 types.String   `tfsdk:"name"`
+	PrivateIpv4          types.String   `tfsdk:"private_ipv4"`
+	PrivateIpv6          types.String   `tfsdk:"private_ipv6"`
+	PrivateSubnetId      types.String   `tfsdk:"private_subnet_id"`
+	ProjectId            types.String   `tfsdk:"project_id"`
+	PublicKey            types.String   `tfsdk:"public_key"`
+	PublicKeyFingerprint types.String   `tfsdk:"public_key_fingerprint"`
+	Size                 types.String   `tfsdk:"size"`
+	SshPublicKeyId       types.String   `tfsdk:"ssh_public_key_id"`
+	StorageSize          types.Int64    `tfsdk:"storage_size"`
+	StorageSizeGib       types.Int64    `tfsdk:"storage_size_gib"`
+	Subnet               types.String   `tfsdk:"subnet"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+	UnixUser             types.String   `tfsdk:"unix_user"`
+}
 
-	uc, ok := req.ProviderData.(UbicloudClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *UbicloudClient, got: %T. Please report this issue to support@ubicloud.com.", req.ProviderData),
-		)
+func vmResourceSchema(ctx context.Context) schema.Schema {
+	s := resource_vm.VmResourceSchema(ctx)
+	s.Description = "Provides a Ubicloud VM resource. This can be used to create and delete VMs."
+
+	addStringValidators(&s, "public_key", sshPublicKeyValidator{})
+	s.Attributes["public_key_fingerprint"] = schema.StringAttribute{
+		Computed:            true,
+		Description:         "SHA256 fingerprint of the public SSH key, as printed by ssh-keygen -l. One per line if several keys are given",
+		MarkdownDescription: "SHA256 fingerprint of the public SSH key, as printed by `ssh-keygen -l`. One per line if several keys are given",
+		PlanModifiers: []planmodifier.String{
+			publicKeyFingerprintPlanModifier{},
+		},
+	}
+	addTimeoutsBlock(ctx, &s, timeouts.Opts{Create: true, Delete: true})
+	return s
+}
 
+func (r *vmResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var publicKey, sshPublicKeyId types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("public_key"), &publicKey)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ssh_public_key_id"), &sshPublicKeyId)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.uc = &uc
-}
-
-func (r *vmResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_vm"
-}
+	if publicKey.IsNull() && sshPublicKeyId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("public_key"),
+			"Missing Attribute Configuration",
+			"Exactly one of public_key or ssh_public_key_id must be configured.",
+		)
+	}
+	if !publicKey.IsNull() && !sshPublicKeyId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ssh_public_key_id"),
+			"Invalid Attribute Combination",
+			"Exactly one of public_key or ssh_public_key_id must be configured, got both.",
+		)
+	}
 
-func (r *vmResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_vm.VmResourceSchema(ctx)
-	resp.Schema.Description = "Provides a Ubicloud VM resource. This can be used to create and delete VMs."
+	// The provider is not configured while Terraform validates the
+	// configuration on its own, the static catalog is used then.
+	c := r.uc.getCatalog(ctx)
+	location := configLocation(ctx, req.Config)
 
+	validateCatalogAttribute(ctx, req.Config, "location", c.locationValidator(), &resp.Diagnostics)
+	validateCatalogAttribute(ctx, req.Config, "size", c.optionValidator("VM size", location, func(l *catalogLocation) []string { return l.vmSizes }), &resp.Diagnostics)
+	validateCatalogAttribute(ctx, req.Config, "boot_image", c.optionValidator("boot image", location, func(l *catalogLocation) []string { return l.bootImages }), &resp.Diagnostics)
 }
 
-func (r *vmResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var state resource_vm.VmModel
+func createVmResource(ctx context.Context, uc *UbicloudClient, state *vmResourceModel) (*ubicloud_client.VmDetailed, error) {
+	body := ubicloud_client.CreateVMJSONRequestBody{}
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
+	if state.PublicKey.ValueString() != "" {
+		body.PublicKey = state.PublicKey.ValueStringPointer()
 	}
-	body := ubicloud_client.CreateVMJSONRequestBody{
-		PublicKey: state.PublicKey.ValueString(),
+	if state.SshPublicKeyId.ValueString() != "" {
+		body.SshPublicKeyId = state.SshPublicKeyId.ValueStringPointer()
 	}
-
 	if state.Size.ValueString() != "" {
 		body.Size = state.Size.ValueStringPointer()
 	}
@@ -88,140 +148,48 @@ func (r *vmResource) Create(ctx context.Context, req resource.CreateRequest, res
 		body.StorageSize = &storageSize
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Creating vm: %s.", vmResourceLogIdentifier(&state)))
-	vmResp, err := r.uc.client.CreateVMWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString(), body)
+	vmResp, err := uc.client.CreateVMWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString(), body)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error creating vm: %s.", vmResourceLogIdentifier(&state)),
-			err.Error(),
-		)
-		return
-	}
-
-	if vmResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code creating vm",
-			fmt.Sprintf("Received %s for vm: %s. Details: %s", vmResp.Status(), vmResourceLogIdentifier(&state), vmResp.Body))
-		return
-	}
-
-	diags := setVmStateResource(ctx, vmResp.JSON200, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+		return nil, err
 	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	return vmResp.JSON200, ubicloud.CheckResponse(vmResp, vmResp.Body, http.StatusOK)
 }
 
-func (r *vmResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_vm.VmModel
-
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	tflog.Debug(ctx, fmt.Sprintf("Reading vm: %s.", vmResourceLogIdentifier(&state)))
-	vmResp, err := r.uc.client.GetVMDetailsWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
+func readVmResource(ctx context.Context, uc *UbicloudClient, state *vmResourceModel) (*ubicloud_client.VmDetailed, error) {
+	vmResp, err := uc.client.GetVMDetailsWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error reading vm: %s.", vmResourceLogIdentifier(&state)),
-			err.Error(),
-		)
-		return
-	}
-
-	if vmResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code reading vm",
-			fmt.Sprintf("Received %s for vm: %s. Details: %s", vmResp.Status(), vmResourceLogIdentifier(&state), vmResp.Body))
-		return
-	}
-
-	diags := setVmStateResource(ctx, vmResp.JSON200, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+		return nil, err
 	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	return vmResp.JSON200, ubicloud.CheckResponse(vmResp, vmResp.Body, http.StatusOK)
 }
 
-func (r *vmResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state resource_vm.VmModel
-
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.AddError(
-		"Update of vm is not supported",
-		fmt.Sprintf("Cannot update vm: %s", vmResourceLogIdentifier(&state)))
-}
-
-func (r *vmResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_vm.VmModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	tflog.Debug(ctx, fmt.Sprintf("Deleting vm: %s.", vmResourceLogIdentifier(&state)))
-	vmResp, err := r.uc.client.DeleteVMWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
+func deleteVmResource(ctx context.Context, uc *UbicloudClient, state *vmResourceModel) error {
+	vmResp, err := uc.client.DeleteVMWithResponse(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error deleting vm: %s.", vmResourceLogIdentifier(&state)),
-			err.Error(),
-		)
-		return
-	}
-
-	if vmResp.StatusCode() != http.StatusNoContent && vmResp.StatusCode() != http.StatusNotFound {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP status code deleting vm",
-			fmt.Sprintf("Received %s for vm: %s. Details: %s", vmResp.Status(), vmResourceLogIdentifier(&state), vmResp.Body))
-		return
+		return err
 	}
+	return ubicloud.CheckResponse(vmResp, vmResp.Body, http.StatusNoContent)
 }
 
-func (r *vmResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: project_id,location,name. Got: %q", req.ID),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("location"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[2])...)
+// waitVmResourceRunning waits until the created VM is running.
+func waitVmResourceRunning(ctx context.Context, uc *UbicloudClient, state *vmResourceModel) (*ubicloud_client.VmDetailed, error) {
+	return uc.client.WaitForVMState(ctx, state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString(), "running", waitInterval)
 }
 
-func setVmStateResource(ctx context.Context, vmd *ubicloud_client.VmDetailed, state *resource_vm.VmModel) diag.Diagnostics {
-	assignStr(vmd.Id, &state.Id)
-	assignStr(vmd.Name, &state.Name)
-	assignStr(vmd.Location, &state.Location)
-	assignStr(vmd.Size, &state.Size)
-	assignStr(vmd.UnixUser, &state.UnixUser)
-	assignInt(vmd.StorageSizeGib, &state.StorageSizeGib)
-	assignStr(vmd.PrivateIpv4, &state.PrivateIpv4)
-	assignStr(vmd.PrivateIpv6, &state.PrivateIpv6)
-	assignStr(vmd.Subnet, &state.Subnet)
+func setVmStateResource(ctx context.Context, vmd *ubicloud_client.VmDetailed, state *vmResourceModel) diag.Diagnostics {
+	diags := mapState(ctx, vmd, state)
 
-	firewallsListValue, diags := GetFirewallsState(ctx, vmd.Firewalls)
-	if diags.HasError() {
-		return diags
+	// ip4 is not returned for VMs created without IPv4, it is null then.
+	if vmd.Ip4 == nil {
+		state.Ip4 = types.StringNull()
 	}
 
-	state.Firewalls = firewallsListValue
+	// The API does not return the public key, the fingerprint is only known
+	// when the key is in the plan or state, e.g. not after an import.
+	setPublicKeyFingerprint(state.PublicKey, &state.PublicKeyFingerprint)
 	return diags
 }
 
-func vmResourceLogIdentifier(state *resource_vm.VmModel) string {
+func vmResourceLogIdentifier(state *vmResourceModel) string {
 	return fmt.Sprintf("project_id=%s, location=%s, name=%s", state.ProjectId.ValueString(), state.Location.ValueString(), state.Name.ValueString())
 }
@@ -1,32 +1,40 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud"
 )
 
 func TestAccVmResource(t *testing.T) {
-	resName := GetRandomResourceName("vm")
-	resourceConfig := fmt.Sprintf(`
+	resName := GetRandomResourceName(t, "vm")
+	subnetConfig := testAccPrivateSubnetConfig(GetRandomResourceName(t, "sn"), GetRandomResourceName(t, "fw"))
+	resourceConfig := func(name string) string {
+		return fmt.Sprintf(`
   resource "ubicloud_vm" "testacc" {
     project_id 				= "%s"
     location   				= "%s"
-    private_subnet_id = "%s"
+    private_subnet_id = ubicloud_private_subnet.testacc.id
     name        			= "%s"
-    public_key  			= "the public key"
+    public_key  			= "%s"
     size							= "standard-2"
     storage_size 			= 40
-  }`, GetTestAccProjectId(), GetTestAccLocation(), GetTestAccPrivateSubnetId(), resName)
+  }`, GetTestAccProjectId(), GetTestAccLocation(), name, testAccPublicKey)
+	}
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		CheckDestroy:             testAccCheckVmSubnetDestroy(t),
 		Steps: []resource.TestStep{
 			// Test Create and Read
 			{
-				Config: providerConfig + resourceConfig,
+				Config: providerConfig + subnetConfig + resourceConfig(resName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("ubicloud_vm.testacc", "id"),
 					resource.TestCheckResourceAttr("ubicloud_vm.testacc", "project_id", GetTestAccProjectId()),
@@ -35,6 +43,9 @@ func TestAccVmResource(t *testing.T) {
 					resource.TestCheckResourceAttr("ubicloud_vm.testacc", "size", "standard-2"),
 					resource.TestCheckResourceAttrSet("ubicloud_vm.testacc", "storage_size_gib"),
 					resource.TestCheckResourceAttrSet("ubicloud_vm.testacc", "unix_user"),
+					resource.TestCheckNoResourceAttr("ubicloud_vm.testacc", "ip4"),
+					resource.TestCheckResourceAttrSet("ubicloud_vm.testacc", "ip6"),
+					resource.TestCheckResourceAttr("ubicloud_vm.testacc", "public_key_fingerprint", testAccPublicKeyFingerprint),
 				),
 			},
 			// Test ImportState
@@ -44,7 +55,98 @@ func TestAccVmResource(t *testing.T) {
 				ImportStateIdFunc: func(state *terraform.State) (string, error) {
 					return fmt.Sprintf("%s,%s,%s", GetTestAccProjectId(), GetTestAccLocation(), resName), nil
 				},
+				ImportStateVerify: true,
+				// The API doesn't return these arguments, and the fingerprint
+				// is only known along with the public key.
+				ImportStateVerifyIgnore: []string{"private_subnet_id", "public_key", "public_key_fingerprint", "storage_size"},
+			},
+			// Test that renaming the VM replaces it
+			{
+				Config: providerConfig + subnetConfig + resourceConfig(resName+"-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ubicloud_vm.testacc", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ubicloud_vm.testacc", "name", resName+"-renamed"),
+					resource.TestCheckResourceAttrPair("ubicloud_vm.testacc", "private_subnet_id", "ubicloud_private_subnet.testacc", "id"),
+				),
 			},
 		},
 	})
 }
+
+func TestAccVmResourceSshPublicKeyId(t *testing.T) {
+	resName := GetRandomResourceName(t, "vm")
+	resourceConfig := testAccPrivateSubnetConfig(GetRandomResourceName(t, "sn"), GetRandomResourceName(t, "fw")) + fmt.Sprintf(`
+  resource "ubicloud_ssh_public_key" "testacc" {
+    project_id = "%s"
+    name       = "%s"
+    public_key = "%s"
+  }
+
+  resource "ubicloud_vm" "testacc" {
+    project_id        = "%s"
+    location          = "%s"
+    private_subnet_id = ubicloud_private_subnet.testacc.id
+    name              = "%s"
+    ssh_public_key_id = ubicloud_ssh_public_key.testacc.id
+  }`, GetTestAccProjectId(), resName, testAccPublicKey, GetTestAccProjectId(), GetTestAccLocation(), resName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		CheckDestroy:             resource.ComposeAggregateTestCheckFunc(testAccCheckVmSubnetDestroy(t), testAccCheckSshPublicKeyDestroy(t)),
+		Steps: []resource.TestStep{
+			// Test that exactly one of public_key and ssh_public_key_id is required
+			{
+				Config: providerConfig + fmt.Sprintf(`
+  resource "ubicloud_vm" "testacc" {
+    project_id = "%s"
+    location   = "%s"
+    name       = "%s"
+  }`, GetTestAccProjectId(), GetTestAccLocation(), resName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Exactly one of public_key or ssh_public_key_id"),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+  resource "ubicloud_vm" "testacc" {
+    project_id        = "%s"
+    location          = "%s"
+    name              = "%s"
+    public_key        = "%s"
+    ssh_public_key_id = "key-id"
+  }`, GetTestAccProjectId(), GetTestAccLocation(), resName, testAccPublicKey),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Exactly one of public_key or ssh_public_key_id"),
+			},
+			// Test Create and Read
+			{
+				Config: providerConfig + resourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ubicloud_vm.testacc", "id"),
+					resource.TestCheckResourceAttrPair("ubicloud_vm.testacc", "ssh_public_key_id", "ubicloud_ssh_public_key.testacc", "id"),
+					resource.TestCheckNoResourceAttr("ubicloud_vm.testacc", "public_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVmDestroy(t *testing.T) resource.TestCheckFunc {
+	return testAccCheckDestroy(t, "ubicloud_vm", func(ctx context.Context, client *ubicloud.Client, attrs map[string]string) (bool, error) {
+		resp, err := client.GetVMDetailsWithIdWithResponse(ctx, attrs["project_id"], attrs["location"], attrs["id"])
+		if err != nil {
+			return false, err
+		}
+		return testAccExists(resp.StatusCode(), resp.Body)
+	})
+}
+
+// testAccCheckVmSubnetDestroy checks the destruction of VMs and of the
+// private subnet and firewall of testAccPrivateSubnetConfig.
+func testAccCheckVmSubnetDestroy(t *testing.T) resource.TestCheckFunc {
+	return resource.ComposeAggregateTestCheckFunc(testAccCheckVmDestroy(t), testAccCheckPrivateSubnetDestroy(t), testAccCheckFirewallDestroy(t))
+}
@@ -0,0 +1,106 @@
+// Package strictapi validates the responses of the Ubicloud API against the
+// OpenAPI spec embedded in the generated client, to find where
+// config/ubicloud_openapi.yml drifted from the API.
+//
+// Validation is opt-in, with UBICLOUD_STRICT_API=1, as the provider keeps
+// working with responses that don't match the spec as long as they decode.
+package strictapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
+)
+
+// Env is the environment variable enabling strict mode when set to 1.
+const Env = "UBICLOUD_STRICT_API"
+
+// Enabled returns whether strict mode is enabled.
+func Enabled() bool {
+	return os.Getenv(Env) == "1"
+}
+
+// ReportFunc is called with the context of a request for each response that
+// doesn't match the spec.
+type ReportFunc func(ctx context.Context, err error)
+
+// Client is an HTTP client of the API validating the responses to the
+// requests it sends, which it returns unchanged.
+type Client struct {
+	doer   ubicloud_client.HttpRequestDoer
+	router routers.Router
+	report ReportFunc
+}
+
+var _ ubicloud_client.HttpRequestDoer = (*Client)(nil)
+
+// NewClient returns a client sending requests with doer, or
+// http.DefaultClient if doer is nil, and reporting mismatches to report.
+func NewClient(doer ubicloud_client.HttpRequestDoer, report ReportFunc) (*Client, error) {
+	spec, err := ubicloud_client.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("loading OpenAPI spec: %w", err)
+	}
+	// Match requests regardless of the endpoint they are sent to.
+	spec.Servers = nil
+
+	router, err := legacy.NewRouter(spec)
+	if err != nil {
+		return nil, fmt.Errorf("loading OpenAPI spec: %w", err)
+	}
+
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+	return &Client{doer: doer, router: router, report: report}, nil
+}
+
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	resp, err := c.doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := c.validate(req, resp, body); err != nil {
+		c.report(req.Context(), fmt.Errorf("%s %s returned %d: %w", req.Method, req.URL.Path, resp.StatusCode, err))
+	}
+	return resp, nil
+}
+
+// validate returns an error if the operation of the request is not in the
+// spec, or if the response doesn't match the spec of the operation.
+// Responses with a status code the operation doesn't list are not validated.
+func (c *Client) validate(req *http.Request, resp *http.Response, body []byte) error {
+	route, pathParams, err := c.router.FindRoute(req)
+	if err != nil {
+		return err
+	}
+
+	input := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{
+			Request:    req,
+			PathParams: pathParams,
+			Route:      route,
+		},
+		Status:  resp.StatusCode,
+		Header:  resp.Header,
+		Options: &openapi3filter.Options{MultiError: true},
+	}
+	input.SetBodyBytes(body)
+	return openapi3filter.ValidateResponse(req.Context(), input)
+}
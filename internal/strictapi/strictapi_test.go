@@ -0,0 +1,73 @@
+package strictapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/project/pj-valid":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"pj-valid","name":"valid","credit":0,"discount":0}`))
+		case "/project/pj-invalid":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":1,"name":"invalid"}`))
+		case "/project/pj-missing":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":404}}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	var reported []error
+	client, err := NewClient(nil, func(_ context.Context, err error) {
+		reported = append(reported, err)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		path     string
+		mismatch string
+	}{
+		{"/project/pj-valid", ""},
+		{"/project/pj-invalid", `GET /project/pj-invalid returned 200: response body doesn't match schema`},
+		// Status codes the spec doesn't list are not validated.
+		{"/project/pj-missing", ""},
+		{"/unknown", "GET /unknown returned 204: no matching operation was found"},
+	}
+	for _, test := range tests {
+		reported = nil
+		req, err := http.NewRequest(http.MethodGet, server.URL+test.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The response is returned unchanged.
+		if body, err := io.ReadAll(resp.Body); err != nil || (resp.StatusCode == http.StatusOK && len(body) == 0) {
+			t.Errorf("%s: unexpected body %q, %v", test.path, body, err)
+		}
+
+		switch {
+		case test.mismatch == "" && len(reported) > 0:
+			t.Errorf("%s: unexpected mismatch: %s", test.path, reported[0])
+		case test.mismatch != "" && len(reported) != 1:
+			t.Errorf("%s: expected a mismatch, got %v", test.path, reported)
+		case test.mismatch != "" && !strings.HasPrefix(reported[0].Error(), test.mismatch):
+			t.Errorf("%s: expected mismatch %q, got %q", test.path, test.mismatch, reported[0])
+		}
+	}
+}
@@ -1,11 +1,9 @@
 package main
 
 //go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen --config=config/go_generator_config.yml config/ubicloud_openapi.yml
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen --config=config/go_server_generator_config.yml config/ubicloud_openapi.yml
 //go:generate go run github.com/hashicorp/terraform-plugin-codegen-openapi/cmd/tfplugingen-openapi generate --config config/tf_generator_config.yml --output config/generated/provider_code_spec.json config/ubicloud_openapi.yml
-//go:generate sh -c "jq '( .resources[] | select(.name == \"vm\" or .name == \"postgres\" or .name == \"private_subnet\" or .name == \"firewall\") | .schema.attributes[] | select(.name == \"project_id\" or .name == \"location\" or .name == \"name\") ).string.computed_optional_required = \"required\"' config/generated/provider_code_spec.json > config/generated/provider_code_spec_mod.tmp.json"
-//go:generate sh -c "jq '( .resources[] | select(.name == \"vm\" or .name == \"private_subnet\") | .schema.attributes[] | select(.name == \"boot_image\" or .name == \"private_subnet_id\" or .name == \"firewall_id\") ).string.computed_optional_required = \"optional\"' config/generated/provider_code_spec_mod.tmp.json > config/generated/provider_code_spec_mod.tmp2.json"
-//go:generate sh -c "jq '( .resources[] | select(.name == \"vm\" or .name == \"postgres\") | .schema.attributes[] | select(.name == \"storage_size\") ).int64.computed_optional_required = \"optional\"' config/generated/provider_code_spec_mod.tmp2.json > config/generated/provider_code_spec_mod.tmp3.json"
-//go:generate sh -c "jq '( .resources[] | select(.name == \"vm\") | .schema.attributes[] | select(.name == \"enable_ip4\") ).bool.computed_optional_required = \"optional\"' config/generated/provider_code_spec_mod.tmp3.json > config/generated/provider_code_spec_mod.json"
+//go:generate go run ./tools/specoverlay -spec config/generated/provider_code_spec.json -overlay config/tf_schema_overlay.yml -output config/generated/provider_code_spec_mod.json
 
 //go:generate go run github.com/hashicorp/terraform-plugin-codegen-framework/cmd/tfplugingen-framework generate data-sources --input config/generated/provider_code_spec_mod.json  --output internal/generated
 //go:generate go run github.com/hashicorp/terraform-plugin-codegen-framework/cmd/tfplugingen-framework generate resources --input config/generated/provider_code_spec_mod.json  --output internal/generated
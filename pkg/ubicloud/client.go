@@ -0,0 +1,123 @@
+// Package ubicloud is a Go client of the Ubicloud API, shared by the provider
+// and other tools talking to Ubicloud.
+//
+// Client wraps the client oapi-codegen generates from
+// config/ubicloud_openapi.yml, whose operations it exposes, and adds
+// authentication and retries of failed requests. CheckResponse turns
+// unexpected responses into typed errors, iterators like ProjectVMs fetch
+// the pages of list operations as they are reached, built on Paginate, and
+// Wait polls objects until they reach a state.
+//
+// The generated client is committed in the ubicloud_client package, so that
+// the package builds without running go generate. CI checks that it matches
+// the spec.
+package ubicloud
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
+
+	"github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
+)
+
+// DefaultEndpoint is the endpoint of the Ubicloud API.
+const DefaultEndpoint = "https://api.ubicloud.com"
+
+// Client is a client of the Ubicloud API. The operations of the API are
+// the methods of the embedded generated client.
+type Client struct {
+	*ubicloud_client.ClientWithResponses
+
+	endpoint string
+}
+
+type options struct {
+	endpoint       string
+	httpClient     HttpRequestDoer
+	retryPolicy    RetryPolicy
+	requestEditors []RequestEditorFn
+}
+
+// Option configures a Client.
+type Option func(*options)
+
+// WithEndpoint sets the endpoint of the API, DefaultEndpoint by default.
+func WithEndpoint(endpoint string) Option {
+	return func(o *options) {
+		o.endpoint = endpoint
+	}
+}
+
+// WithHTTPClient sets the client sending the requests, http.DefaultClient by
+// default. Failed requests are retried on top of it.
+func WithHTTPClient(httpClient HttpRequestDoer) Option {
+	return func(o *options) {
+		o.httpClient = httpClient
+	}
+}
+
+// WithRetryPolicy sets how failed requests are retried, DefaultRetryPolicy
+// by default.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retryPolicy = policy
+	}
+}
+
+// WithRequestEditorFn adds a function editing every request before it is
+// sent, e.g. to set a User-Agent.
+func WithRequestEditorFn(fn RequestEditorFn) Option {
+	return func(o *options) {
+		o.requestEditors = append(o.requestEditors, fn)
+	}
+}
+
+// NewClient returns a client of the API authenticating with token.
+func NewClient(token string, opts ...Option) (*Client, error) {
+	if token == "" {
+		return nil, errors.New("ubicloud: missing API token")
+	}
+
+	o := options{
+		endpoint:    DefaultEndpoint,
+		retryPolicy: DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	auth, err := securityprovider.NewSecurityProviderBearerToken(token)
+	if err != nil {
+		return nil, fmt.Errorf("ubicloud: %w", err)
+	}
+
+	var httpClient HttpRequestDoer = http.DefaultClient
+	if o.httpClient != nil {
+		httpClient = o.httpClient
+	}
+	if o.retryPolicy.MaxRetries > 0 {
+		httpClient = &retryClient{doer: httpClient, policy: o.retryPolicy}
+	}
+
+	clientOptions := []ubicloud_client.ClientOption{
+		ubicloud_client.WithHTTPClient(httpClient),
+		ubicloud_client.WithRequestEditorFn(auth.Intercept),
+	}
+	for _, fn := range o.requestEditors {
+		clientOptions = append(clientOptions, ubicloud_client.WithRequestEditorFn(fn))
+	}
+
+	client, err := ubicloud_client.NewClientWithResponses(o.endpoint, clientOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("ubicloud: %w", err)
+	}
+	return &Client{ClientWithResponses: client, endpoint: o.endpoint}, nil
+}
+
+// Endpoint returns the endpoint of the API the client sends requests to.
+func (c *Client) Endpoint() string {
+	return c.endpoint
+}
@@ -0,0 +1,133 @@
+package ubicloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/fakeapi"
+)
+
+const testLocation = "eu-central-h1"
+
+// testRetryPolicy retries like DefaultRetryPolicy without slowing tests down.
+var testRetryPolicy = RetryPolicy{MaxRetries: 3, MinDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+
+// countingClient sends requests with http.DefaultClient and counts them.
+type countingClient struct {
+	requests atomic.Int32
+	query    atomic.Pointer[url.Values]
+}
+
+func (c *countingClient) Do(req *http.Request) (*http.Response, error) {
+	c.requests.Add(1)
+	query := req.URL.Query()
+	c.query.Store(&query)
+	return http.DefaultClient.Do(req)
+}
+
+// lastQuery returns the query parameters of the last request.
+func (c *countingClient) lastQuery() url.Values {
+	if query := c.query.Load(); query != nil {
+		return *query
+	}
+	return nil
+}
+
+// newTestClient returns a client of a new fake API, counting its requests,
+// and the ID of a project of the fake.
+func newTestClient(t *testing.T, opts ...Option) (*Client, *fakeapi.Server, *countingClient, string) {
+	t.Helper()
+
+	s := fakeapi.NewServer()
+	t.Cleanup(s.Close)
+
+	counter := &countingClient{}
+	opts = append([]Option{WithEndpoint(s.URL), WithHTTPClient(counter), WithRetryPolicy(testRetryPolicy)}, opts...)
+	client, err := NewClient(fakeapi.Token, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, s, counter, s.AddProject("test")
+}
+
+func createTestVms(t *testing.T, client *Client, projectId string, count int) {
+	t.Helper()
+
+	for i := range count {
+		resp, err := client.CreateVMWithResponse(context.Background(), projectId, testLocation, fmt.Sprintf("test-vm-%d", i), CreateVMJSONRequestBody{
+			PublicKey: ptr("ssh-ed25519 AAAA test"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := CheckResponse(resp, resp.Body, http.StatusOK); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	client, _, _, projectId := newTestClient(t)
+
+	resp, err := client.GetProjectWithResponse(context.Background(), projectId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckResponse(resp, resp.Body, http.StatusOK); err != nil {
+		t.Errorf("expected the request to be authenticated, got %s", err)
+	}
+}
+
+func TestNewClientInvalidToken(t *testing.T) {
+	s := fakeapi.NewServer()
+	t.Cleanup(s.Close)
+
+	client, err := NewClient("invalid", WithEndpoint(s.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.ListProjectsWithResponse(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var apiErr *Error
+	if err := CheckResponse(resp, resp.Body, http.StatusOK); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected an unauthorized error, got %v", err)
+	}
+}
+
+func TestNewClientMissingToken(t *testing.T) {
+	if _, err := NewClient(""); err == nil {
+		t.Error("expected an error without token")
+	}
+}
+
+func TestNewClientOptions(t *testing.T) {
+	var userAgent string
+	client, _, _, projectId := newTestClient(t, WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+		req.Header.Set("User-Agent", "test")
+		userAgent = req.Header.Get("User-Agent")
+		return nil
+	}))
+
+	if _, err := client.GetProjectWithResponse(context.Background(), projectId); err != nil {
+		t.Fatal(err)
+	}
+	if userAgent != "test" {
+		t.Errorf("expected the request editor to be called")
+	}
+	if client.Endpoint() == DefaultEndpoint {
+		t.Errorf("expected the endpoint of the fake, got %s", client.Endpoint())
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,59 @@
+package ubicloud
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+// ErrNotFound is matched by the errors of requests for objects that don't
+// exist, with errors.Is.
+var ErrNotFound = errors.New("not found")
+
+// Response is implemented by the responses of the operations of Client.
+type Response interface {
+	Status() string
+	StatusCode() int
+}
+
+// Error is returned for responses of the API with an unexpected status code.
+type Error struct {
+	Status     string
+	StatusCode int
+	// Type and Message describe the error, if the API returned one, e.g.
+	// "ResourceNotFound".
+	Type    string
+	Message string
+	Body    []byte
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("Received %s. Details: %s", e.Status, e.Body)
+}
+
+func (e *Error) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// CheckResponse returns an *Error if the status code of resp, whose body is
+// body, is not one of statusCodes.
+func CheckResponse(resp Response, body []byte, statusCodes ...int) error {
+	if slices.Contains(statusCodes, resp.StatusCode()) {
+		return nil
+	}
+
+	err := &Error{Status: resp.Status(), StatusCode: resp.StatusCode(), Body: body}
+	var errorBody struct {
+		Error struct {
+			Type    string `json:"type"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &errorBody) == nil {
+		err.Type = errorBody.Error.Type
+		err.Message = errorBody.Error.Message
+	}
+	return err
+}
@@ -0,0 +1,54 @@
+package ubicloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCheckResponse(t *testing.T) {
+	client, _, _, projectId := newTestClient(t)
+	ctx := context.Background()
+
+	resp, err := client.GetVMDetailsWithResponse(ctx, projectId, testLocation, "missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := CheckResponse(resp, resp.Body, http.StatusOK, http.StatusNotFound); err != nil {
+		t.Errorf("expected no error for an expected status, got %s", err)
+	}
+
+	err = CheckResponse(resp, resp.Body, http.StatusOK)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+	if !errors.Is(fmt.Errorf("getting VM: %w", err), ErrNotFound) {
+		t.Errorf("expected a wrapped not found error to match, got %v", err)
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an *Error, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.Type != "ResourceNotFound" || apiErr.Message == "" {
+		t.Errorf("unexpected error: %+v", apiErr)
+	}
+}
+
+func TestErrorIsNotFound(t *testing.T) {
+	tests := map[int]bool{
+		http.StatusNotFound:            true,
+		http.StatusBadRequest:          false,
+		http.StatusInternalServerError: false,
+	}
+
+	for status, expected := range tests {
+		err := &Error{Status: http.StatusText(status), StatusCode: status, Body: []byte("not json")}
+		if got := errors.Is(err, ErrNotFound); got != expected {
+			t.Errorf("%d: expected %t, got %t", status, expected, got)
+		}
+	}
+}
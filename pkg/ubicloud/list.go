@@ -0,0 +1,183 @@
+package ubicloud
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+type listOptions struct {
+	pageSize int
+}
+
+// ListOption configures an iterator over a list operation.
+type ListOption func(*listOptions)
+
+// WithPageSize sets the number of items fetched per request,
+// DefaultPageSize by default.
+func WithPageSize(pageSize int) ListOption {
+	return func(o *listOptions) {
+		o.pageSize = pageSize
+	}
+}
+
+// list returns an iterator over the items of a list operation, as Paginate,
+// configured with opts.
+func list[T any](ctx context.Context, opts []ListOption, page PageFunc[T], id func(T) *string) iter.Seq2[T, error] {
+	var o listOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return Paginate(ctx, o.pageSize, page, id)
+}
+
+// pageOrderColumn is the order_column parameter of the pages of list
+// operations.
+var pageOrderColumn = PageOrderColumn
+
+// newPage returns the page of a list operation whose response is resp, with
+// body, and the items and count of the page.
+func newPage[T any](resp Response, body []byte, items *[]T, count *int) (Page[T], error) {
+	if err := CheckResponse(resp, body, http.StatusOK); err != nil {
+		return Page[T]{}, err
+	}
+	if items == nil {
+		return Page[T]{}, fmt.Errorf("ubicloud: missing items in the page: %s", body)
+	}
+	return Page[T]{Items: *items, Count: count}, nil
+}
+
+// Projects returns an iterator over the projects visible to the user.
+func (c *Client) Projects(ctx context.Context, opts ...ListOption) iter.Seq2[Project, error] {
+	return list(ctx, opts, func(ctx context.Context, startAfter *string, size int) (Page[Project], error) {
+		resp, err := c.ListProjectsWithResponse(ctx, &ListProjectsParams{StartAfter: startAfter, PageSize: &size, OrderColumn: &pageOrderColumn})
+		if err != nil {
+			return Page[Project]{}, err
+		}
+		var items *[]Project
+		var count *int
+		if resp.JSON200 != nil {
+			items, count = resp.JSON200.Items, resp.JSON200.Count
+		}
+		return newPage(resp, resp.Body, items, count)
+	}, func(project Project) *string { return project.Id })
+}
+
+// ProjectVMs returns an iterator over the VMs of the project, in all
+// locations.
+func (c *Client) ProjectVMs(ctx context.Context, projectId string, opts ...ListOption) iter.Seq2[Vm, error] {
+	return list(ctx, opts, func(ctx context.Context, startAfter *string, size int) (Page[Vm], error) {
+		resp, err := c.ListProjectVMsWithResponse(ctx, projectId, &ListProjectVMsParams{StartAfter: startAfter, PageSize: &size, OrderColumn: &pageOrderColumn})
+		if err != nil {
+			return Page[Vm]{}, err
+		}
+		var items *[]Vm
+		var count *int
+		if resp.JSON200 != nil {
+			items, count = resp.JSON200.Items, resp.JSON200.Count
+		}
+		return newPage(resp, resp.Body, items, count)
+	}, func(vm Vm) *string { return vm.Id })
+}
+
+// LocationVMs returns an iterator over the VMs of the project in location.
+func (c *Client) LocationVMs(ctx context.Context, projectId string, location string, opts ...ListOption) iter.Seq2[Vm, error] {
+	return list(ctx, opts, func(ctx context.Context, startAfter *string, size int) (Page[Vm], error) {
+		resp, err := c.ListLocationVMsWithResponse(ctx, projectId, location, &ListLocationVMsParams{StartAfter: startAfter, PageSize: &size, OrderColumn: &pageOrderColumn})
+		if err != nil {
+			return Page[Vm]{}, err
+		}
+		var items *[]Vm
+		var count *int
+		if resp.JSON200 != nil {
+			items, count = resp.JSON200.Items, resp.JSON200.Count
+		}
+		return newPage(resp, resp.Body, items, count)
+	}, func(vm Vm) *string { return vm.Id })
+}
+
+// PostgresDatabases returns an iterator over the PostgreSQL databases of the
+// project, in all locations.
+func (c *Client) PostgresDatabases(ctx context.Context, projectId string, opts ...ListOption) iter.Seq2[Postgres, error] {
+	return list(ctx, opts, func(ctx context.Context, startAfter *string, size int) (Page[Postgres], error) {
+		resp, err := c.ListPostgresDatabasesWithResponse(ctx, projectId, &ListPostgresDatabasesParams{StartAfter: startAfter, PageSize: &size, OrderColumn: &pageOrderColumn})
+		if err != nil {
+			return Page[Postgres]{}, err
+		}
+		var items *[]Postgres
+		var count *int
+		if resp.JSON200 != nil {
+			items, count = resp.JSON200.Items, resp.JSON200.Count
+		}
+		return newPage(resp, resp.Body, items, count)
+	}, func(pg Postgres) *string { return pg.Id })
+}
+
+// LocationPostgresDatabases returns an iterator over the PostgreSQL databases
+// of the project in location.
+func (c *Client) LocationPostgresDatabases(ctx context.Context, projectId string, location string, opts ...ListOption) iter.Seq2[Postgres, error] {
+	return list(ctx, opts, func(ctx context.Context, startAfter *string, size int) (Page[Postgres], error) {
+		resp, err := c.ListLocationPostgresDatabasesWithResponse(ctx, projectId, location, &ListLocationPostgresDatabasesParams{StartAfter: startAfter, PageSize: &size, OrderColumn: &pageOrderColumn})
+		if err != nil {
+			return Page[Postgres]{}, err
+		}
+		var items *[]Postgres
+		var count *int
+		if resp.JSON200 != nil {
+			items, count = resp.JSON200.Items, resp.JSON200.Count
+		}
+		return newPage(resp, resp.Body, items, count)
+	}, func(pg Postgres) *string { return pg.Id })
+}
+
+// PrivateSubnets returns an iterator over the private subnets of the
+// project, in all locations.
+func (c *Client) PrivateSubnets(ctx context.Context, projectId string, opts ...ListOption) iter.Seq2[PrivateSubnet, error] {
+	return list(ctx, opts, func(ctx context.Context, startAfter *string, size int) (Page[PrivateSubnet], error) {
+		resp, err := c.ListPSsWithResponse(ctx, projectId, &ListPSsParams{StartAfter: startAfter, PageSize: &size, OrderColumn: &pageOrderColumn})
+		if err != nil {
+			return Page[PrivateSubnet]{}, err
+		}
+		var items *[]PrivateSubnet
+		var count *int
+		if resp.JSON200 != nil {
+			items, count = resp.JSON200.Items, resp.JSON200.Count
+		}
+		return newPage(resp, resp.Body, items, count)
+	}, func(ps PrivateSubnet) *string { return ps.Id })
+}
+
+// LocationPrivateSubnets returns an iterator over the private subnets of the
+// project in location.
+func (c *Client) LocationPrivateSubnets(ctx context.Context, projectId string, location string, opts ...ListOption) iter.Seq2[PrivateSubnet, error] {
+	return list(ctx, opts, func(ctx context.Context, startAfter *string, size int) (Page[PrivateSubnet], error) {
+		resp, err := c.ListLocationPrivateSubnetsWithResponse(ctx, projectId, location, &ListLocationPrivateSubnetsParams{StartAfter: startAfter, PageSize: &size, OrderColumn: &pageOrderColumn})
+		if err != nil {
+			return Page[PrivateSubnet]{}, err
+		}
+		var items *[]PrivateSubnet
+		var count *int
+		if resp.JSON200 != nil {
+			items, count = resp.JSON200.Items, resp.JSON200.Count
+		}
+		return newPage(resp, resp.Body, items, count)
+	}, func(ps PrivateSubnet) *string { return ps.Id })
+}
+
+// LocationFirewalls returns an iterator over the firewalls of the project in
+// location.
+func (c *Client) LocationFirewalls(ctx context.Context, projectId string, location string, opts ...ListOption) iter.Seq2[Firewall, error] {
+	return list(ctx, opts, func(ctx context.Context, startAfter *string, size int) (Page[Firewall], error) {
+		resp, err := c.ListLocationFirewallsWithResponse(ctx, projectId, location, &ListLocationFirewallsParams{StartAfter: startAfter, PageSize: &size, OrderColumn: &pageOrderColumn})
+		if err != nil {
+			return Page[Firewall]{}, err
+		}
+		var items *[]Firewall
+		var count *int
+		if resp.JSON200 != nil {
+			items, count = resp.JSON200.Items, resp.JSON200.Count
+		}
+		return newPage(resp, resp.Body, items, count)
+	}, func(fw Firewall) *string { return fw.Id })
+}
@@ -0,0 +1,183 @@
+package ubicloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"testing"
+
+	"github.com/ubicloud/terraform-provider-ubicloud/internal/fakeapi"
+)
+
+// collect returns the names of the items of seq, as returned by name.
+func collect[T any](seq iter.Seq2[T, error], name func(T) *string) ([]string, error) {
+	var names []string
+	for item, err := range seq {
+		if err != nil {
+			return names, err
+		}
+		names = append(names, *name(item))
+	}
+	return names, nil
+}
+
+// listCase lists 5 objects of a type created by setup, in projectId.
+type listCase struct {
+	setup func(t *testing.T, client *Client, s *fakeapi.Server, projectId string)
+	list  func(ctx context.Context, client *Client, projectId string, opts ...ListOption) ([]string, error)
+}
+
+var listCases = map[string]listCase{
+	"projects": {
+		setup: func(t *testing.T, client *Client, s *fakeapi.Server, projectId string) {
+			for i := range 4 {
+				s.AddProject(fmt.Sprintf("test-%d", i))
+			}
+		},
+		list: func(ctx context.Context, client *Client, projectId string, opts ...ListOption) ([]string, error) {
+			return collect(client.Projects(ctx, opts...), func(p Project) *string { return p.Name })
+		},
+	},
+	"project VMs": {
+		setup: func(t *testing.T, client *Client, s *fakeapi.Server, projectId string) {
+			createTestVms(t, client, projectId, 5)
+		},
+		list: func(ctx context.Context, client *Client, projectId string, opts ...ListOption) ([]string, error) {
+			return collect(client.ProjectVMs(ctx, projectId, opts...), func(vm Vm) *string { return vm.Name })
+		},
+	},
+	"location VMs": {
+		setup: func(t *testing.T, client *Client, s *fakeapi.Server, projectId string) {
+			createTestVms(t, client, projectId, 5)
+		},
+		list: func(ctx context.Context, client *Client, projectId string, opts ...ListOption) ([]string, error) {
+			return collect(client.LocationVMs(ctx, projectId, testLocation, opts...), func(vm Vm) *string { return vm.Name })
+		},
+	},
+	"postgres databases": {
+		setup: createTestPostgres,
+		list: func(ctx context.Context, client *Client, projectId string, opts ...ListOption) ([]string, error) {
+			return collect(client.PostgresDatabases(ctx, projectId, opts...), func(pg Postgres) *string { return pg.Name })
+		},
+	},
+	"location postgres databases": {
+		setup: createTestPostgres,
+		list: func(ctx context.Context, client *Client, projectId string, opts ...ListOption) ([]string, error) {
+			return collect(client.LocationPostgresDatabases(ctx, projectId, testLocation, opts...), func(pg Postgres) *string { return pg.Name })
+		},
+	},
+	"private subnets": {
+		setup: addTestPrivateSubnets,
+		list: func(ctx context.Context, client *Client, projectId string, opts ...ListOption) ([]string, error) {
+			return collect(client.PrivateSubnets(ctx, projectId, opts...), func(ps PrivateSubnet) *string { return ps.Name })
+		},
+	},
+	"location private subnets": {
+		setup: addTestPrivateSubnets,
+		list: func(ctx context.Context, client *Client, projectId string, opts ...ListOption) ([]string, error) {
+			return collect(client.LocationPrivateSubnets(ctx, projectId, testLocation, opts...), func(ps PrivateSubnet) *string { return ps.Name })
+		},
+	},
+	"location firewalls": {
+		setup: func(t *testing.T, client *Client, s *fakeapi.Server, projectId string) {
+			for i := range 5 {
+				s.AddFirewall(projectId, testLocation, fmt.Sprintf("test-firewall-%d", i))
+			}
+		},
+		list: func(ctx context.Context, client *Client, projectId string, opts ...ListOption) ([]string, error) {
+			return collect(client.LocationFirewalls(ctx, projectId, testLocation, opts...), func(fw Firewall) *string { return fw.Name })
+		},
+	},
+}
+
+func createTestPostgres(t *testing.T, client *Client, _ *fakeapi.Server, projectId string) {
+	t.Helper()
+
+	for i := range 5 {
+		resp, err := client.CreatePostgresDatabaseWithResponse(context.Background(), projectId, testLocation, fmt.Sprintf("test-pg-%d", i), CreatePostgresDatabaseJSONRequestBody{
+			Size: "standard-2",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := CheckResponse(resp, resp.Body, http.StatusOK); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func addTestPrivateSubnets(_ *testing.T, _ *Client, s *fakeapi.Server, projectId string) {
+	for i := range 5 {
+		s.AddPrivateSubnet(projectId, testLocation, fmt.Sprintf("test-subnet-%d", i), "")
+	}
+}
+
+func TestList(t *testing.T) {
+	tests := map[string]struct {
+		opts     []ListOption
+		requests int32
+	}{
+		// A single short page.
+		"default page size": {requests: 1},
+		// 3 pages, the last one short.
+		"page size": {opts: []ListOption{WithPageSize(2)}, requests: 3},
+		// A single full page, with all the items of the list.
+		"count": {opts: []ListOption{WithPageSize(5)}, requests: 1},
+	}
+
+	for name, lc := range listCases {
+		for testName, test := range tests {
+			t.Run(name+"/"+testName, func(t *testing.T) {
+				client, s, counter, projectId := newTestClient(t)
+				lc.setup(t, client, s, projectId)
+				counter.requests.Store(0)
+
+				names, err := lc.list(context.Background(), client, projectId, test.opts...)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(names) != 5 {
+					t.Errorf("expected 5 items, got %v", names)
+				}
+				if got := counter.requests.Load(); got != test.requests {
+					t.Errorf("expected %d requests, got %d", test.requests, got)
+				}
+				if got := counter.lastQuery().Get("order_column"); got != PageOrderColumn {
+					t.Errorf("expected order_column %q, got %q", PageOrderColumn, got)
+				}
+			})
+		}
+	}
+}
+
+func TestListContextCanceled(t *testing.T) {
+	for name, lc := range listCases {
+		t.Run(name, func(t *testing.T) {
+			client, s, counter, projectId := newTestClient(t)
+			lc.setup(t, client, s, projectId)
+			counter.requests.Store(0)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			if _, err := lc.list(ctx, client, projectId); !errors.Is(err, context.Canceled) {
+				t.Errorf("expected the error of the context, got %v", err)
+			}
+			if got := counter.requests.Load(); got != 0 {
+				t.Errorf("expected no request, got %d", got)
+			}
+		})
+	}
+}
+
+func TestListError(t *testing.T) {
+	client, s, _, projectId := newTestClient(t)
+	s.InjectError(http.MethodGet, "/project/*/location/*/vm", http.StatusForbidden, 1)
+
+	_, err := collect(client.LocationVMs(context.Background(), projectId, testLocation), func(vm Vm) *string { return vm.Name })
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("expected the error of the API, got %v", err)
+	}
+}
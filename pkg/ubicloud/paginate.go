@@ -0,0 +1,79 @@
+package ubicloud
+
+import (
+	"context"
+	"errors"
+	"iter"
+)
+
+// DefaultPageSize is the page size of the API, which Paginate uses when
+// none is given.
+const DefaultPageSize = 10
+
+// PageOrderColumn is the order_column of the pages fetched by a PageFunc. A
+// page starts after the ID of the last item of the previous one, which only
+// skips no item when the pages are ordered by ID.
+const PageOrderColumn = "id"
+
+// Page is a page of items of a list operation.
+type Page[T any] struct {
+	Items []T
+	// Count is the number of items of the whole list, nil if the API did not
+	// return it.
+	Count *int
+}
+
+// PageFunc fetches the page of at most pageSize items following the item
+// whose ID is startAfter, or the first page if startAfter is nil. Pages must
+// be ordered by PageOrderColumn.
+type PageFunc[T any] func(ctx context.Context, startAfter *string, pageSize int) (Page[T], error)
+
+// Paginate returns an iterator over the items of a list operation of the API,
+// which the pages fetched by page are made of. id returns the ID of an item,
+// for the next page to start after the last item of the previous one. A
+// pageSize of 0 is DefaultPageSize.
+//
+// Pages are fetched lazily, as the iteration reaches them, so that breaking
+// out of it stops fetching. The last page is the first one with fewer than
+// pageSize items, or the one reaching the count of items of the list, so
+// that deleting items of the list during the iteration can end it early.
+// Iteration stops after the first error, yielded with the zero T, which is
+// the error of ctx if it is done before a page is fetched.
+func Paginate[T any](ctx context.Context, pageSize int, page PageFunc[T], id func(T) *string) iter.Seq2[T, error] {
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+	return func(yield func(T, error) bool) {
+		var zero T
+		var startAfter *string
+		var yielded int
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			p, err := page(ctx, startAfter, pageSize)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range p.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			yielded += len(p.Items)
+			if len(p.Items) < pageSize || p.Count != nil && yielded >= *p.Count {
+				return
+			}
+
+			startAfter = id(p.Items[len(p.Items)-1])
+			if startAfter == nil {
+				yield(zero, errors.New("ubicloud: the last item of the page has no ID to fetch the next page after"))
+				return
+			}
+		}
+	}
+}
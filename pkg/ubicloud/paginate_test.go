@@ -0,0 +1,173 @@
+package ubicloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+// listTestVms returns the pages of the VMs of the project.
+func listTestVms(client *Client, projectId string) PageFunc[Vm] {
+	return func(ctx context.Context, startAfter *string, pageSize int) (Page[Vm], error) {
+		params := &ListProjectVMsParams{StartAfter: startAfter, PageSize: &pageSize}
+		resp, err := client.ListProjectVMsWithResponse(ctx, projectId, params)
+		if err != nil {
+			return Page[Vm]{}, err
+		}
+		if err := CheckResponse(resp, resp.Body, http.StatusOK); err != nil {
+			return Page[Vm]{}, err
+		}
+		return Page[Vm]{Items: *resp.JSON200.Items, Count: resp.JSON200.Count}, nil
+	}
+}
+
+func vmId(vm Vm) *string {
+	return vm.Id
+}
+
+func TestPaginate(t *testing.T) {
+	client, _, counter, projectId := newTestClient(t)
+	createTestVms(t, client, projectId, 5)
+	counter.requests.Store(0)
+
+	var names []string
+	for vm, err := range Paginate(context.Background(), 2, listTestVms(client, projectId), vmId) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, *vm.Name)
+	}
+
+	if len(names) != 5 || names[0] != "test-vm-0" || names[4] != "test-vm-4" {
+		t.Errorf("unexpected VMs: %v", names)
+	}
+	// 3 pages of VMs, the last one short.
+	if got := counter.requests.Load(); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+}
+
+func TestPaginateLastPage(t *testing.T) {
+	tests := map[string]struct {
+		withCount bool
+		requests  int32
+	}{
+		// The second page holds the last of the count of VMs.
+		"count": {withCount: true, requests: 2},
+		// Without the count, only the empty page after the second one is
+		// shorter than the page size.
+		"no count": {requests: 3},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, _, counter, projectId := newTestClient(t)
+			createTestVms(t, client, projectId, 4)
+			counter.requests.Store(0)
+
+			page := listTestVms(client, projectId)
+			if !test.withCount {
+				countedPage := page
+				page = func(ctx context.Context, startAfter *string, pageSize int) (Page[Vm], error) {
+					p, err := countedPage(ctx, startAfter, pageSize)
+					p.Count = nil
+					return p, err
+				}
+			}
+
+			var count int
+			for _, err := range Paginate(context.Background(), 2, page, vmId) {
+				if err != nil {
+					t.Fatal(err)
+				}
+				count++
+			}
+
+			if count != 4 {
+				t.Errorf("expected 4 VMs, got %d", count)
+			}
+			if got := counter.requests.Load(); got != test.requests {
+				t.Errorf("expected %d requests, got %d", test.requests, got)
+			}
+		})
+	}
+}
+
+func TestPaginateDefaultPageSize(t *testing.T) {
+	client, _, _, projectId := newTestClient(t)
+
+	var pageSizes []int
+	page := func(ctx context.Context, startAfter *string, pageSize int) (Page[Vm], error) {
+		pageSizes = append(pageSizes, pageSize)
+		return listTestVms(client, projectId)(ctx, startAfter, pageSize)
+	}
+	for _, err := range Paginate(context.Background(), 0, page, vmId) {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(pageSizes) != 1 || pageSizes[0] != DefaultPageSize {
+		t.Errorf("expected a single page of %d items, got page sizes %v", DefaultPageSize, pageSizes)
+	}
+}
+
+func TestPaginateLazily(t *testing.T) {
+	client, _, counter, projectId := newTestClient(t)
+	createTestVms(t, client, projectId, 5)
+	counter.requests.Store(0)
+
+	for _, err := range Paginate(context.Background(), 2, listTestVms(client, projectId), vmId) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		break
+	}
+
+	if got := counter.requests.Load(); got != 1 {
+		t.Errorf("expected only the first page to be fetched, got %d requests", got)
+	}
+}
+
+func TestPaginateError(t *testing.T) {
+	client, s, _, projectId := newTestClient(t)
+	createTestVms(t, client, projectId, 3)
+	s.InjectError(http.MethodGet, "/project/*/vm", http.StatusForbidden, 1)
+
+	var errs []error
+	for _, err := range Paginate(context.Background(), 2, listTestVms(client, projectId), vmId) {
+		errs = append(errs, err)
+	}
+
+	var apiErr *Error
+	if len(errs) != 1 || !errors.As(errs[0], &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("expected a single error, got %v", errs)
+	}
+}
+
+func TestPaginateContextCanceled(t *testing.T) {
+	client, _, counter, projectId := newTestClient(t)
+	createTestVms(t, client, projectId, 5)
+	counter.requests.Store(0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var count int
+	var err error
+	for _, err = range Paginate(ctx, 2, listTestVms(client, projectId), vmId) {
+		if err != nil {
+			break
+		}
+		count++
+		cancel()
+	}
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the error of the context, got %v", err)
+	}
+	if count != 2 || counter.requests.Load() != 1 {
+		t.Errorf("expected the first page only, got %d VMs in %d requests", count, counter.requests.Load())
+	}
+}
@@ -0,0 +1,109 @@
+package ubicloud
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures the retries of requests the API was too busy or
+// unavailable to process, or that failed on the network.
+type RetryPolicy struct {
+	// MaxRetries is the number of times a request is retried, 0 disabling
+	// retries.
+	MaxRetries int
+	// MinDelay is the delay before the first retry, doubled for each of the
+	// next ones. The Retry-After header of a response takes precedence.
+	// MaxDelay caps both if it is not 0.
+	MinDelay time.Duration
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy of clients.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinDelay:   time.Second,
+	MaxDelay:   30 * time.Second,
+}
+
+// retryClient retries the requests sent with doer according to policy.
+// Rate limited requests are retried whatever their method, as the API did
+// not process them. Requests failing on the network or because the API is
+// unavailable are only retried if their method is idempotent, as they may
+// have been processed.
+type retryClient struct {
+	doer   HttpRequestDoer
+	policy RetryPolicy
+}
+
+func (c *retryClient) Do(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := c.doer.Do(req)
+		if attempt >= c.policy.MaxRetries || !retryable(req, resp, err) {
+			return resp, err
+		}
+
+		delay := c.policy.delay(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func retryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body can't be sent again.
+		return false
+	}
+
+	if err != nil {
+		return req.Context().Err() == nil && idempotent(req.Method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent(req.Method)
+	}
+	return false
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// delay returns the delay before retrying after attempt, which got resp.
+func (p RetryPolicy) delay(attempt int, resp *http.Response) time.Duration {
+	delay := p.MinDelay << min(attempt, 16)
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			delay = time.Duration(seconds) * time.Second
+		}
+	}
+	if p.MaxDelay > 0 {
+		delay = min(delay, p.MaxDelay)
+	}
+	return delay
+}
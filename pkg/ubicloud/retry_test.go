@@ -0,0 +1,107 @@
+package ubicloud
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	tests := map[string]struct {
+		method   string
+		status   int
+		times    int
+		requests int32
+		expected int
+	}{
+		"unavailable":                {method: http.MethodGet, status: http.StatusServiceUnavailable, times: 2, requests: 3, expected: http.StatusOK},
+		"unavailable too many times": {method: http.MethodGet, status: http.StatusBadGateway, times: 5, requests: 4, expected: http.StatusBadGateway},
+		"unavailable on create":      {method: http.MethodPost, status: http.StatusServiceUnavailable, times: 1, requests: 1, expected: http.StatusServiceUnavailable},
+		"rate limited on create":     {method: http.MethodPost, status: http.StatusTooManyRequests, times: 1, requests: 2, expected: http.StatusOK},
+		"client error":               {method: http.MethodGet, status: http.StatusBadRequest, times: 1, requests: 1, expected: http.StatusBadRequest},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, s, counter, projectId := newTestClient(t)
+			ctx := context.Background()
+			s.InjectError(test.method, "/project/*/location/*/private-subnet/*", test.status, test.times)
+
+			var status int
+			if test.method == http.MethodPost {
+				resp, err := client.CreatePrivateSubnetWithResponse(ctx, projectId, testLocation, "test-subnet", CreatePrivateSubnetJSONRequestBody{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				status = resp.StatusCode()
+			} else {
+				s.AddPrivateSubnet(projectId, testLocation, "test-subnet", "")
+				resp, err := client.GetPrivateSubnetDetailsWithResponse(ctx, projectId, testLocation, "test-subnet")
+				if err != nil {
+					t.Fatal(err)
+				}
+				status = resp.StatusCode()
+			}
+
+			if status != test.expected {
+				t.Errorf("expected status %d, got %d", test.expected, status)
+			}
+			if got := counter.requests.Load(); got != test.requests {
+				t.Errorf("expected %d requests, got %d", test.requests, got)
+			}
+		})
+	}
+}
+
+func TestRetryDisabled(t *testing.T) {
+	client, s, counter, projectId := newTestClient(t, WithRetryPolicy(RetryPolicy{}))
+	s.InjectError(http.MethodGet, "/project/*", http.StatusServiceUnavailable, 1)
+
+	resp, err := client.GetProjectWithResponse(context.Background(), projectId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusServiceUnavailable || counter.requests.Load() != 1 {
+		t.Errorf("expected a single failed request, got %d after %d requests", resp.StatusCode(), counter.requests.Load())
+	}
+}
+
+func TestRetryContextCanceled(t *testing.T) {
+	client, s, _, projectId := newTestClient(t, WithRetryPolicy(RetryPolicy{MaxRetries: 1, MinDelay: time.Hour}))
+	s.InjectError(http.MethodGet, "/project/*", http.StatusServiceUnavailable, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetProjectWithResponse(ctx, projectId); err == nil {
+		t.Error("expected the error of the context while waiting to retry")
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 10, MinDelay: time.Second, MaxDelay: 10 * time.Second}
+	tests := map[string]struct {
+		attempt    int
+		retryAfter string
+		expected   time.Duration
+	}{
+		"first":               {attempt: 0, expected: time.Second},
+		"second":              {attempt: 1, expected: 2 * time.Second},
+		"capped":              {attempt: 5, expected: 10 * time.Second},
+		"retry after":         {attempt: 0, retryAfter: "3", expected: 3 * time.Second},
+		"retry after capped":  {attempt: 0, retryAfter: "60", expected: 10 * time.Second},
+		"invalid retry after": {attempt: 1, retryAfter: "soon", expected: 2 * time.Second},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if test.retryAfter != "" {
+				resp.Header.Set("Retry-After", test.retryAfter)
+			}
+			if got := policy.delay(test.attempt, resp); got != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package ubicloud
+
+import "github.com/ubicloud/terraform-provider-ubicloud/pkg/ubicloud/ubicloud_client"
+
+// The types of the generated client, so that code using Client can name
+// them without importing the ubicloud_client package.
+type (
+	HttpRequestDoer = ubicloud_client.HttpRequestDoer
+	RequestEditorFn = ubicloud_client.RequestEditorFn
+)
+
+// Objects of the API.
+type (
+	Firewall             = ubicloud_client.Firewall
+	FirewallRule         = ubicloud_client.FirewallRule
+	LocationCatalog      = ubicloud_client.LocationCatalog
+	Nic                  = ubicloud_client.Nic
+	Postgres             = ubicloud_client.Postgres
+	PostgresDetailed     = ubicloud_client.PostgresDetailed
+	PostgresFirewallRule = ubicloud_client.PostgresFirewallRule
+	PrivateSubnet        = ubicloud_client.PrivateSubnet
+	Project              = ubicloud_client.Project
+	SshPublicKey         = ubicloud_client.SshPublicKey
+	Vm                   = ubicloud_client.Vm
+	VmDetailed           = ubicloud_client.VmDetailed
+)
+
+// Request bodies of the operations of the API.
+type (
+	AttachFirewallSubnetJSONRequestBody          = ubicloud_client.AttachFirewallSubnetJSONRequestBody
+	CreateFirewallJSONRequestBody                = ubicloud_client.CreateFirewallJSONRequestBody
+	CreateFirewallRuleJSONRequestBody            = ubicloud_client.CreateFirewallRuleJSONRequestBody
+	CreatePostgresDatabaseJSONRequestBody        = ubicloud_client.CreatePostgresDatabaseJSONRequestBody
+	CreatePostgresFirewallRuleJSONRequestBody    = ubicloud_client.CreatePostgresFirewallRuleJSONRequestBody
+	CreatePrivateSubnetJSONRequestBody           = ubicloud_client.CreatePrivateSubnetJSONRequestBody
+	CreateProjectJSONRequestBody                 = ubicloud_client.CreateProjectJSONRequestBody
+	CreateSshPublicKeyJSONRequestBody            = ubicloud_client.CreateSshPublicKeyJSONRequestBody
+	CreateVMJSONRequestBody                      = ubicloud_client.CreateVMJSONRequestBody
+	DetachFirewallSubnetJSONRequestBody          = ubicloud_client.DetachFirewallSubnetJSONRequestBody
+	LoginJSONRequestBody                         = ubicloud_client.LoginJSONRequestBody
+	RenameFirewallJSONRequestBody                = ubicloud_client.RenameFirewallJSONRequestBody
+	ResetSuperuserPasswordJSONRequestBody        = ubicloud_client.ResetSuperuserPasswordJSONRequestBody
+	ResetSuperuserPasswordWithIDJSONRequestBody  = ubicloud_client.ResetSuperuserPasswordWithIDJSONRequestBody
+	RestorePostgresDatabaseJSONRequestBody       = ubicloud_client.RestorePostgresDatabaseJSONRequestBody
+	RestorePostgresDatabaseWithIDJSONRequestBody = ubicloud_client.RestorePostgresDatabaseWithIDJSONRequestBody
+	UpdateFirewallJSONRequestBody                = ubicloud_client.UpdateFirewallJSONRequestBody
+	UpdateSshPublicKeyJSONRequestBody            = ubicloud_client.UpdateSshPublicKeyJSONRequestBody
+)
+
+// Parameters of the list operations of the API.
+type (
+	ListLocationFirewallsParams         = ubicloud_client.ListLocationFirewallsParams
+	ListLocationPostgresDatabasesParams = ubicloud_client.ListLocationPostgresDatabasesParams
+	ListLocationPrivateSubnetsParams    = ubicloud_client.ListLocationPrivateSubnetsParams
+	ListLocationVMsParams               = ubicloud_client.ListLocationVMsParams
+	ListPostgresDatabasesParams         = ubicloud_client.ListPostgresDatabasesParams
+	ListProjectVMsParams                = ubicloud_client.ListProjectVMsParams
+	ListProjectsParams                  = ubicloud_client.ListProjectsParams
+	ListPSsParams                       = ubicloud_client.ListPSsParams
+)
@@ -0,0 +1,7860 @@
+// Package ubicloud_client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package ubicloud_client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Firewall defines model for Firewall.
+type Firewall struct {
+	// Description Description of the firewall
+	Description *string `json:"description,omitempty"`
+
+	// FirewallRules List of firewall rules
+	FirewallRules *[]FirewallRule `json:"firewall_rules,omitempty"`
+
+	// Id ID of the firewall
+	Id *string `json:"id,omitempty"`
+
+	// Location Location of the firewall
+	Location *string `json:"location,omitempty"`
+
+	// Name Name of the firewall
+	Name *string `json:"name,omitempty"`
+}
+
+// FirewallRule defines model for FirewallRule.
+type FirewallRule struct {
+	// Cidr CIDR of the firewall rule
+	Cidr *string `json:"cidr,omitempty"`
+
+	// Id ID of the firewall rule
+	Id *string `json:"id,omitempty"`
+
+	// PortRange Port range of the firewall rule
+	PortRange *string `json:"port_range,omitempty"`
+}
+
+// LocationCatalog defines model for LocationCatalog.
+type LocationCatalog struct {
+	// BootImages Boot images available in the location
+	BootImages *[]string `json:"boot_images,omitempty"`
+
+	// DisplayName Human readable name of the location
+	DisplayName *string `json:"display_name,omitempty"`
+
+	// Name Name of the location
+	Name *string `json:"name,omitempty"`
+
+	// PostgresHaTypes Postgres high availability types available in the location
+	PostgresHaTypes *[]string `json:"postgres_ha_types,omitempty"`
+
+	// PostgresSizes Postgres sizes available in the location
+	PostgresSizes *[]string `json:"postgres_sizes,omitempty"`
+
+	// PostgresVersions Postgres versions available in the location
+	PostgresVersions *[]string `json:"postgres_versions,omitempty"`
+
+	// VmSizes VM sizes available in the location
+	VmSizes *[]string `json:"vm_sizes,omitempty"`
+}
+
+// Nic defines model for Nic.
+type Nic struct {
+	// Id ID of the NIC
+	Id *string `json:"id,omitempty"`
+
+	// Name Name of the NIC
+	Name *string `json:"name,omitempty"`
+
+	// PrivateIpv4 Private IPv4 address
+	PrivateIpv4 *string `json:"private_ipv4,omitempty"`
+
+	// PrivateIpv6 Private IPv6 address
+	PrivateIpv6 *string `json:"private_ipv6,omitempty"`
+
+	// VmName Name of the VM
+	VmName *string `json:"vm_name,omitempty"`
+}
+
+// Postgres defines model for Postgres.
+type Postgres struct {
+	// HaType High availability type
+	HaType *string `json:"ha_type,omitempty"`
+
+	// Id ID of the Postgres database
+	Id *string `json:"id,omitempty"`
+
+	// Location Location of the Postgres database
+	Location *string `json:"location,omitempty"`
+
+	// Name Name of the Postgres database
+	Name *string `json:"name,omitempty"`
+
+	// State State of the Postgres database
+	State *string `json:"state,omitempty"`
+
+	// StorageSizeGib Storage size in GiB
+	StorageSizeGib *int `json:"storage_size_gib,omitempty"`
+
+	// Version Requested Postgres version
+	Version *string `json:"version,omitempty"`
+
+	// VmSize Size of the underlying VM
+	VmSize *string `json:"vm_size,omitempty"`
+}
+
+// PostgresDetailed defines model for PostgresDetailed.
+type PostgresDetailed struct {
+	// ConnectionString Connection string to the Postgres database
+	ConnectionString *string `json:"connection_string,omitempty"`
+
+	// EarliestRestoreTime Earliest restore time (if primary)
+	EarliestRestoreTime *string `json:"earliest_restore_time,omitempty"`
+
+	// FirewallRules List of Postgres firewall rules
+	FirewallRules *[]PostgresFirewallRule `json:"firewall_rules,omitempty"`
+
+	// HaType High availability type
+	HaType *string `json:"ha_type,omitempty"`
+
+	// Id ID of the Postgres database
+	Id *string `json:"id,omitempty"`
+
+	// LatestRestoreTime Latest restore time (if primary)
+	LatestRestoreTime *string `json:"latest_restore_time,omitempty"`
+
+	// Location Location of the Postgres database
+	Location *string `json:"location,omitempty"`
+
+	// Name Name of the Postgres database
+	Name *string `json:"name,omitempty"`
+
+	// Primary Is the database primary
+	Primary *bool `json:"primary,omitempty"`
+
+	// State State of the Postgres database
+	State *string `json:"state,omitempty"`
+
+	// StorageSizeGib Storage size in GiB
+	StorageSizeGib *int `json:"storage_size_gib,omitempty"`
+
+	// Version Requested Postgres version
+	Version *string `json:"version,omitempty"`
+
+	// VmSize Size of the underlying VM
+	VmSize *string `json:"vm_size,omitempty"`
+}
+
+// PostgresFirewallRule defines model for PostgresFirewallRule.
+type PostgresFirewallRule struct {
+	// Cidr CIDR of the Postgres firewall rule
+	Cidr *string `json:"cidr,omitempty"`
+
+	// Id ID of the Postgres firewall rule
+	Id *string `json:"id,omitempty"`
+}
+
+// PrivateSubnet defines model for PrivateSubnet.
+type PrivateSubnet struct {
+	Firewalls *[]Firewall `json:"firewalls,omitempty"`
+
+	// Id ID of the subnet
+	Id *string `json:"id,omitempty"`
+
+	// Location Location of the subnet
+	Location *string `json:"location,omitempty"`
+
+	// Name Name of the subnet
+	Name *string `json:"name,omitempty"`
+
+	// Net4 IPv4 CIDR of the subnet
+	Net4 *string `json:"net4,omitempty"`
+
+	// Net6 IPv6 CIDR of the subnet
+	Net6 *string `json:"net6,omitempty"`
+
+	// Nics List of NICs
+	Nics *[]Nic `json:"nics,omitempty"`
+}
+
+// Project defines model for Project.
+type Project struct {
+	// Credit Remaining credit of the project in $
+	Credit *float32 `json:"credit,omitempty"`
+
+	// Discount Discount of the project as percentage
+	Discount *int    `json:"discount,omitempty"`
+	Id       *string `json:"id,omitempty"`
+
+	// Name Name of the project
+	Name *string `json:"name,omitempty"`
+}
+
+// SshPublicKey defines model for SshPublicKey.
+type SshPublicKey struct {
+	// Id ID of the SSH public key
+	Id *string `json:"id,omitempty"`
+
+	// Name Name of the SSH public key
+	Name *string `json:"name,omitempty"`
+
+	// PublicKey SSH public keys in the authorized_keys format, one per line
+	PublicKey *string `json:"public_key,omitempty"`
+}
+
+// Vm defines model for Vm.
+type Vm struct {
+	// Id ID of the VM
+	Id *string `json:"id,omitempty"`
+
+	// Ip4 IPv4 address
+	Ip4 *string `json:"ip4,omitempty"`
+
+	// Ip6 IPv6 address
+	Ip6 *string `json:"ip6,omitempty"`
+
+	// Location Location of the VM
+	Location *string `json:"location,omitempty"`
+
+	// Name Name of the VM
+	Name *string `json:"name,omitempty"`
+
+	// Size Size of the underlying VM
+	Size *string `json:"size,omitempty"`
+
+	// State State of the VM
+	State *string `json:"state,omitempty"`
+
+	// StorageSizeGib Storage size in GiB
+	StorageSizeGib *int `json:"storage_size_gib,omitempty"`
+
+	// UnixUser Unix user of the VM
+	UnixUser *string `json:"unix_user,omitempty"`
+}
+
+// VmDetailed defines model for VmDetailed.
+type VmDetailed struct {
+	// Firewalls List of firewalls
+	Firewalls *[]Firewall `json:"firewalls,omitempty"`
+
+	// Id ID of the VM
+	Id *string `json:"id,omitempty"`
+
+	// Ip4 IPv4 address
+	Ip4 *string `json:"ip4,omitempty"`
+
+	// Ip6 IPv6 address
+	Ip6 *string `json:"ip6,omitempty"`
+
+	// Location Location of the VM
+	Location *string `json:"location,omitempty"`
+
+	// Name Name of the VM
+	Name *string `json:"name,omitempty"`
+
+	// PrivateIpv4 Private IPv4 address
+	PrivateIpv4 *string `json:"private_ipv4,omitempty"`
+
+	// PrivateIpv6 Private IPv6 address
+	PrivateIpv6 *string `json:"private_ipv6,omitempty"`
+
+	// Size Size of the underlying VM
+	Size *string `json:"size,omitempty"`
+
+	// State State of the VM
+	State *string `json:"state,omitempty"`
+
+	// StorageSizeGib Storage size in GiB
+	StorageSizeGib *int `json:"storage_size_gib,omitempty"`
+
+	// Subnet Subnet of the VM
+	Subnet *string `json:"subnet,omitempty"`
+
+	// UnixUser Unix user of the VM
+	UnixUser *string `json:"unix_user,omitempty"`
+}
+
+// FirewallName defines model for firewall_name.
+type FirewallName = string
+
+// FirewallRuleId defines model for firewall_rule_id.
+type FirewallRuleId = string
+
+// Location defines model for location.
+type Location = string
+
+// OrderColumn defines model for order_column.
+type OrderColumn = string
+
+// PageSize defines model for page_size.
+type PageSize = int
+
+// PostgresDatabaseId defines model for postgres_database_id.
+type PostgresDatabaseId = string
+
+// PostgresDatabaseName defines model for postgres_database_name.
+type PostgresDatabaseName = string
+
+// PrivateSubnetId defines model for private_subnet_id.
+type PrivateSubnetId = string
+
+// PrivateSubnetName defines model for private_subnet_name.
+type PrivateSubnetName = string
+
+// ProjectId defines model for project_id.
+type ProjectId = string
+
+// SshPublicKeyId defines model for ssh_public_key_id.
+type SshPublicKeyId = string
+
+// StartAfter defines model for start_after.
+type StartAfter = string
+
+// VmId defines model for vm_id.
+type VmId = string
+
+// VmName defines model for vm_name.
+type VmName = string
+
+// LoginJSONBody defines parameters for Login.
+type LoginJSONBody struct {
+	Login    string `json:"login"`
+	Password string `json:"password"`
+}
+
+// ListProjectsParams defines parameters for ListProjects.
+type ListProjectsParams struct {
+	// StartAfter Pagination - Start after
+	StartAfter *StartAfter `form:"start_after,omitempty" json:"start_after,omitempty"`
+
+	// PageSize Pagination - Page size
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// OrderColumn Pagination - Order column
+	OrderColumn *OrderColumn `form:"order_column,omitempty" json:"order_column,omitempty"`
+}
+
+// CreateProjectJSONBody defines parameters for CreateProject.
+type CreateProjectJSONBody struct {
+	Name string `json:"name"`
+}
+
+// ListLocationFirewallsParams defines parameters for ListLocationFirewalls.
+type ListLocationFirewallsParams struct {
+	// StartAfter Pagination - Start after
+	StartAfter *StartAfter `form:"start_after,omitempty" json:"start_after,omitempty"`
+
+	// PageSize Pagination - Page size
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// OrderColumn Pagination - Order column
+	OrderColumn *OrderColumn `form:"order_column,omitempty" json:"order_column,omitempty"`
+}
+
+// UpdateFirewallJSONBody defines parameters for UpdateFirewall.
+type UpdateFirewallJSONBody struct {
+	// Description Description of the firewall
+	Description *string `json:"description,omitempty"`
+}
+
+// CreateFirewallJSONBody defines parameters for CreateFirewall.
+type CreateFirewallJSONBody struct {
+	// Description Description of the firewall
+	Description *string `json:"description,omitempty"`
+}
+
+// AttachFirewallSubnetJSONBody defines parameters for AttachFirewallSubnet.
+type AttachFirewallSubnetJSONBody struct {
+	// PrivateSubnetId ID of the private subnet
+	PrivateSubnetId string `json:"private_subnet_id"`
+}
+
+// DetachFirewallSubnetJSONBody defines parameters for DetachFirewallSubnet.
+type DetachFirewallSubnetJSONBody struct {
+	// PrivateSubnetId ID of the private subnet
+	PrivateSubnetId string `json:"private_subnet_id"`
+}
+
+// CreateFirewallRuleJSONBody defines parameters for CreateFirewallRule.
+type CreateFirewallRuleJSONBody struct {
+	// Cidr CIDR of the firewall rule
+	Cidr string `json:"cidr"`
+
+	// PortRange Port range of the firewall rule
+	PortRange *string `json:"port_range,omitempty"`
+}
+
+// RenameFirewallJSONBody defines parameters for RenameFirewall.
+type RenameFirewallJSONBody struct {
+	// Name New name of the firewall
+	Name string `json:"name"`
+}
+
+// ListLocationPostgresDatabasesParams defines parameters for ListLocationPostgresDatabases.
+type ListLocationPostgresDatabasesParams struct {
+	// StartAfter Pagination - Start after
+	StartAfter *StartAfter `form:"start_after,omitempty" json:"start_after,omitempty"`
+
+	// PageSize Pagination - Page size
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// OrderColumn Pagination - Order column
+	OrderColumn *OrderColumn `form:"order_column,omitempty" json:"order_column,omitempty"`
+}
+
+// ResetSuperuserPasswordWithIDJSONBody defines parameters for ResetSuperuserPasswordWithID.
+type ResetSuperuserPasswordWithIDJSONBody struct {
+	Password string `json:"password"`
+}
+
+// RestorePostgresDatabaseWithIDJSONBody defines parameters for RestorePostgresDatabaseWithID.
+type RestorePostgresDatabaseWithIDJSONBody struct {
+	Name          string `json:"name"`
+	RestoreTarget string `json:"restore_target"`
+}
+
+// CreatePostgresDatabaseJSONBody defines parameters for CreatePostgresDatabase.
+type CreatePostgresDatabaseJSONBody struct {
+	// HaType High availability type
+	HaType *string `json:"ha_type,omitempty"`
+
+	// Size Requested size for the underlying VM
+	Size string `json:"size"`
+
+	// StorageSize Requested storage size in GiB
+	StorageSize *int `json:"storage_size,omitempty"`
+
+	// Version Requested Postgres version
+	Version *string `json:"version,omitempty"`
+}
+
+// CreatePostgresFirewallRuleJSONBody defines parameters for CreatePostgresFirewallRule.
+type CreatePostgresFirewallRuleJSONBody struct {
+	// Cidr CIDR of the Postgres firewall rule
+	Cidr string `json:"cidr"`
+}
+
+// ResetSuperuserPasswordJSONBody defines parameters for ResetSuperuserPassword.
+type ResetSuperuserPasswordJSONBody struct {
+	Password string `json:"password"`
+}
+
+// RestorePostgresDatabaseJSONBody defines parameters for RestorePostgresDatabase.
+type RestorePostgresDatabaseJSONBody struct {
+	Name          string `json:"name"`
+	RestoreTarget string `json:"restore_target"`
+}
+
+// ListLocationPrivateSubnetsParams defines parameters for ListLocationPrivateSubnets.
+type ListLocationPrivateSubnetsParams struct {
+	// StartAfter Pagination - Start after
+	StartAfter *StartAfter `form:"start_after,omitempty" json:"start_after,omitempty"`
+
+	// PageSize Pagination - Page size
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// OrderColumn Pagination - Order column
+	OrderColumn *OrderColumn `form:"order_column,omitempty" json:"order_column,omitempty"`
+}
+
+// CreatePrivateSubnetJSONBody defines parameters for CreatePrivateSubnet.
+type CreatePrivateSubnetJSONBody struct {
+	FirewallId *string `json:"firewall_id,omitempty"`
+}
+
+// ListLocationVMsParams defines parameters for ListLocationVMs.
+type ListLocationVMsParams struct {
+	// StartAfter Pagination - Start after
+	StartAfter *StartAfter `form:"start_after,omitempty" json:"start_after,omitempty"`
+
+	// PageSize Pagination - Page size
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// OrderColumn Pagination - Order column
+	OrderColumn *OrderColumn `form:"order_column,omitempty" json:"order_column,omitempty"`
+}
+
+// CreateVMJSONBody defines parameters for CreateVM.
+type CreateVMJSONBody struct {
+	// BootImage Boot image of the VM
+	BootImage *string `json:"boot_image,omitempty"`
+
+	// EnableIp4 Enable IPv4
+	EnableIp4 *bool `json:"enable_ip4,omitempty"`
+
+	// PrivateSubnetId ID of the private subnet
+	PrivateSubnetId *string `json:"private_subnet_id,omitempty"`
+
+	// PublicKey Public SSH key for the VM. Either public_key or ssh_public_key_id is required
+	PublicKey *string `json:"public_key,omitempty"`
+
+	// Size Size of the VM
+	Size *string `json:"size,omitempty"`
+
+	// SshPublicKeyId ID of an SSH public key of the project to use for the VM. Either public_key or ssh_public_key_id is required
+	SshPublicKeyId *string `json:"ssh_public_key_id,omitempty"`
+
+	// StorageSize Requested storage size in GiB
+	StorageSize *int `json:"storage_size,omitempty"`
+
+	// UnixUser Unix user of the VM
+	UnixUser *string `json:"unix_user,omitempty"`
+}
+
+// ListPostgresDatabasesParams defines parameters for ListPostgresDatabases.
+type ListPostgresDatabasesParams struct {
+	// StartAfter Pagination - Start after
+	StartAfter *StartAfter `form:"start_after,omitempty" json:"start_after,omitempty"`
+
+	// PageSize Pagination - Page size
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// OrderColumn Pagination - Order column
+	OrderColumn *OrderColumn `form:"order_column,omitempty" json:"order_column,omitempty"`
+}
+
+// ListPSsParams defines parameters for ListPSs.
+type ListPSsParams struct {
+	// StartAfter Pagination - Start after
+	StartAfter *StartAfter `form:"start_after,omitempty" json:"start_after,omitempty"`
+
+	// PageSize Pagination - Page size
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// OrderColumn Pagination - Order column
+	OrderColumn *OrderColumn `form:"order_column,omitempty" json:"order_column,omitempty"`
+}
+
+// CreateSshPublicKeyJSONBody defines parameters for CreateSshPublicKey.
+type CreateSshPublicKeyJSONBody struct {
+	// Name Name of the SSH public key
+	Name string `json:"name"`
+
+	// PublicKey SSH public keys in the authorized_keys format, one per line
+	PublicKey string `json:"public_key"`
+}
+
+// UpdateSshPublicKeyJSONBody defines parameters for UpdateSshPublicKey.
+type UpdateSshPublicKeyJSONBody struct {
+	// Name Name of the SSH public key
+	Name *string `json:"name,omitempty"`
+
+	// PublicKey SSH public keys in the authorized_keys format, one per line
+	PublicKey *string `json:"public_key,omitempty"`
+}
+
+// ListProjectVMsParams defines parameters for ListProjectVMs.
+type ListProjectVMsParams struct {
+	// StartAfter Pagination - Start after
+	StartAfter *StartAfter `form:"start_after,omitempty" json:"start_after,omitempty"`
+
+	// PageSize Pagination - Page size
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// OrderColumn Pagination - Order column
+	OrderColumn *OrderColumn `form:"order_column,omitempty" json:"order_column,omitempty"`
+}
+
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody LoginJSONBody
+
+// CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
+type CreateProjectJSONRequestBody CreateProjectJSONBody
+
+// UpdateFirewallJSONRequestBody defines body for UpdateFirewall for application/json ContentType.
+type UpdateFirewallJSONRequestBody UpdateFirewallJSONBody
+
+// CreateFirewallJSONRequestBody defines body for CreateFirewall for application/json ContentType.
+type CreateFirewallJSONRequestBody CreateFirewallJSONBody
+
+// AttachFirewallSubnetJSONRequestBody defines body for AttachFirewallSubnet for application/json ContentType.
+type AttachFirewallSubnetJSONRequestBody AttachFirewallSubnetJSONBody
+
+// DetachFirewallSubnetJSONRequestBody defines body for DetachFirewallSubnet for application/json ContentType.
+type DetachFirewallSubnetJSONRequestBody DetachFirewallSubnetJSONBody
+
+// CreateFirewallRuleJSONRequestBody defines body for CreateFirewallRule for application/json ContentType.
+type CreateFirewallRuleJSONRequestBody CreateFirewallRuleJSONBody
+
+// RenameFirewallJSONRequestBody defines body for RenameFirewall for application/json ContentType.
+type RenameFirewallJSONRequestBody RenameFirewallJSONBody
+
+// ResetSuperuserPasswordWithIDJSONRequestBody defines body for ResetSuperuserPasswordWithID for application/json ContentType.
+type ResetSuperuserPasswordWithIDJSONRequestBody ResetSuperuserPasswordWithIDJSONBody
+
+// RestorePostgresDatabaseWithIDJSONRequestBody defines body for RestorePostgresDatabaseWithID for application/json ContentType.
+type RestorePostgresDatabaseWithIDJSONRequestBody RestorePostgresDatabaseWithIDJSONBody
+
+// CreatePostgresDatabaseJSONRequestBody defines body for CreatePostgresDatabase for application/json ContentType.
+type CreatePostgresDatabaseJSONRequestBody CreatePostgresDatabaseJSONBody
+
+// CreatePostgresFirewallRuleJSONRequestBody defines body for CreatePostgresFirewallRule for application/json ContentType.
+type CreatePostgresFirewallRuleJSONRequestBody CreatePostgresFirewallRuleJSONBody
+
+// ResetSuperuserPasswordJSONRequestBody defines body for ResetSuperuserPassword for application/json ContentType.
+type ResetSuperuserPasswordJSONRequestBody ResetSuperuserPasswordJSONBody
+
+// RestorePostgresDatabaseJSONRequestBody defines body for RestorePostgresDatabase for application/json ContentType.
+type RestorePostgresDatabaseJSONRequestBody RestorePostgresDatabaseJSONBody
+
+// CreatePrivateSubnetJSONRequestBody defines body for CreatePrivateSubnet for application/json ContentType.
+type CreatePrivateSubnetJSONRequestBody CreatePrivateSubnetJSONBody
+
+// CreateVMJSONRequestBody defines body for CreateVM for application/json ContentType.
+type CreateVMJSONRequestBody CreateVMJSONBody
+
+// CreateSshPublicKeyJSONRequestBody defines body for CreateSshPublicKey for application/json ContentType.
+type CreateSshPublicKeyJSONRequestBody CreateSshPublicKeyJSONBody
+
+// UpdateSshPublicKeyJSONRequestBody defines body for UpdateSshPublicKey for application/json ContentType.
+type UpdateSshPublicKeyJSONRequestBody UpdateSshPublicKeyJSONBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListLocations request
+	ListLocations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoginWithBody request with any body
+	LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProjects request
+	ListProjects(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProjectWithBody request with any body
+	CreateProjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateProject(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProject request
+	DeleteProject(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProject request
+	GetProject(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListLocationFirewalls request
+	ListLocationFirewalls(ctx context.Context, projectId ProjectId, location Location, params *ListLocationFirewallsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteFirewall request
+	DeleteFirewall(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFirewallDetails request
+	GetFirewallDetails(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateFirewallWithBody request with any body
+	UpdateFirewallWithBody(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateFirewall(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body UpdateFirewallJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateFirewallWithBody request with any body
+	CreateFirewallWithBody(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateFirewall(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body CreateFirewallJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AttachFirewallSubnetWithBody request with any body
+	AttachFirewallSubnetWithBody(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AttachFirewallSubnet(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body AttachFirewallSubnetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DetachFirewallSubnetWithBody request with any body
+	DetachFirewallSubnetWithBody(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DetachFirewallSubnet(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body DetachFirewallSubnetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateFirewallRuleWithBody request with any body
+	CreateFirewallRuleWithBody(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateFirewallRule(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body CreateFirewallRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteFirewallRule request
+	DeleteFirewallRule(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFirewallRuleDetails request
+	GetFirewallRuleDetails(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RenameFirewallWithBody request with any body
+	RenameFirewallWithBody(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RenameFirewall(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body RenameFirewallJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListLocationPostgresDatabases request
+	ListLocationPostgresDatabases(ctx context.Context, projectId ProjectId, location Location, params *ListLocationPostgresDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePostgresDatabaseWithID request
+	DeletePostgresDatabaseWithID(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPostgresDetailsWithId request
+	GetPostgresDetailsWithId(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetSuperuserPasswordWithIDWithBody request with any body
+	ResetSuperuserPasswordWithIDWithBody(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ResetSuperuserPasswordWithID(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, body ResetSuperuserPasswordWithIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestorePostgresDatabaseWithIDWithBody request with any body
+	RestorePostgresDatabaseWithIDWithBody(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RestorePostgresDatabaseWithID(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, body RestorePostgresDatabaseWithIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePostgresDatabase request
+	DeletePostgresDatabase(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPostgresDatabaseDetails request
+	GetPostgresDatabaseDetails(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePostgresDatabaseWithBody request with any body
+	CreatePostgresDatabaseWithBody(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePostgresDatabase(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body CreatePostgresDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePostgresFirewallRuleWithBody request with any body
+	CreatePostgresFirewallRuleWithBody(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePostgresFirewallRule(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body CreatePostgresFirewallRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePostgresFirewallRule request
+	DeletePostgresFirewallRule(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPostgresFirewallRuleDetails request
+	GetPostgresFirewallRuleDetails(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetSuperuserPasswordWithBody request with any body
+	ResetSuperuserPasswordWithBody(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ResetSuperuserPassword(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body ResetSuperuserPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestorePostgresDatabaseWithBody request with any body
+	RestorePostgresDatabaseWithBody(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RestorePostgresDatabase(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body RestorePostgresDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListLocationPrivateSubnets request
+	ListLocationPrivateSubnets(ctx context.Context, projectId ProjectId, location Location, params *ListLocationPrivateSubnetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePSWithId request
+	DeletePSWithId(ctx context.Context, projectId ProjectId, location Location, privateSubnetId PrivateSubnetId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPSDetailsWithId request
+	GetPSDetailsWithId(ctx context.Context, projectId ProjectId, location Location, privateSubnetId PrivateSubnetId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePrivateSubnet request
+	DeletePrivateSubnet(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPrivateSubnetDetails request
+	GetPrivateSubnetDetails(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePrivateSubnetWithBody request with any body
+	CreatePrivateSubnetWithBody(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePrivateSubnet(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, body CreatePrivateSubnetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListLocationVMs request
+	ListLocationVMs(ctx context.Context, projectId ProjectId, location string, params *ListLocationVMsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteVMWithId request
+	DeleteVMWithId(ctx context.Context, projectId ProjectId, location Location, vmId VmId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetVMDetailsWithId request
+	GetVMDetailsWithId(ctx context.Context, projectId ProjectId, location Location, vmId VmId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteVM request
+	DeleteVM(ctx context.Context, projectId ProjectId, location Location, vmName VmName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetVMDetails request
+	GetVMDetails(ctx context.Context, projectId ProjectId, location Location, vmName VmName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateVMWithBody request with any body
+	CreateVMWithBody(ctx context.Context, projectId ProjectId, location Location, vmName VmName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateVM(ctx context.Context, projectId ProjectId, location Location, vmName VmName, body CreateVMJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPostgresDatabases request
+	ListPostgresDatabases(ctx context.Context, projectId ProjectId, params *ListPostgresDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPSs request
+	ListPSs(ctx context.Context, projectId ProjectId, params *ListPSsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSshPublicKeys request
+	ListSshPublicKeys(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSshPublicKeyWithBody request with any body
+	CreateSshPublicKeyWithBody(ctx context.Context, projectId ProjectId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSshPublicKey(ctx context.Context, projectId ProjectId, body CreateSshPublicKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSshPublicKey request
+	DeleteSshPublicKey(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSshPublicKeyDetails request
+	GetSshPublicKeyDetails(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSshPublicKeyWithBody request with any body
+	UpdateSshPublicKeyWithBody(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSshPublicKey(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, body UpdateSshPublicKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProjectVMs request
+	ListProjectVMs(ctx context.Context, projectId ProjectId, params *ListProjectVMsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListLocations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLocationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListProjects(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateProjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateProject(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteProject(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectRequest(c.Server, projectId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProject(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectRequest(c.Server, projectId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListLocationFirewalls(ctx context.Context, projectId ProjectId, location Location, params *ListLocationFirewallsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLocationFirewallsRequest(c.Server, projectId, location, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteFirewall(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteFirewallRequest(c.Server, projectId, location, firewallName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetFirewallDetails(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFirewallDetailsRequest(c.Server, projectId, location, firewallName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateFirewallWithBody(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFirewallRequestWithBody(c.Server, projectId, location, firewallName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateFirewall(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body UpdateFirewallJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFirewallRequest(c.Server, projectId, location, firewallName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateFirewallWithBody(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFirewallRequestWithBody(c.Server, projectId, location, firewallName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateFirewall(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body CreateFirewallJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFirewallRequest(c.Server, projectId, location, firewallName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AttachFirewallSubnetWithBody(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachFirewallSubnetRequestWithBody(c.Server, projectId, location, firewallName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AttachFirewallSubnet(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body AttachFirewallSubnetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachFirewallSubnetRequest(c.Server, projectId, location, firewallName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DetachFirewallSubnetWithBody(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDetachFirewallSubnetRequestWithBody(c.Server, projectId, location, firewallName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DetachFirewallSubnet(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body DetachFirewallSubnetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDetachFirewallSubnetRequest(c.Server, projectId, location, firewallName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateFirewallRuleWithBody(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFirewallRuleRequestWithBody(c.Server, projectId, location, firewallName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateFirewallRule(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body CreateFirewallRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFirewallRuleRequest(c.Server, projectId, location, firewallName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteFirewallRule(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteFirewallRuleRequest(c.Server, projectId, location, firewallName, firewallRuleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetFirewallRuleDetails(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFirewallRuleDetailsRequest(c.Server, projectId, location, firewallName, firewallRuleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RenameFirewallWithBody(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRenameFirewallRequestWithBody(c.Server, projectId, location, firewallName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RenameFirewall(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body RenameFirewallJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRenameFirewallRequest(c.Server, projectId, location, firewallName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListLocationPostgresDatabases(ctx context.Context, projectId ProjectId, location Location, params *ListLocationPostgresDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLocationPostgresDatabasesRequest(c.Server, projectId, location, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePostgresDatabaseWithID(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePostgresDatabaseWithIDRequest(c.Server, projectId, location, postgresDatabaseId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPostgresDetailsWithId(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPostgresDetailsWithIdRequest(c.Server, projectId, location, postgresDatabaseId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetSuperuserPasswordWithIDWithBody(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetSuperuserPasswordWithIDRequestWithBody(c.Server, projectId, location, postgresDatabaseId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetSuperuserPasswordWithID(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, body ResetSuperuserPasswordWithIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetSuperuserPasswordWithIDRequest(c.Server, projectId, location, postgresDatabaseId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestorePostgresDatabaseWithIDWithBody(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestorePostgresDatabaseWithIDRequestWithBody(c.Server, projectId, location, postgresDatabaseId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestorePostgresDatabaseWithID(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, body RestorePostgresDatabaseWithIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestorePostgresDatabaseWithIDRequest(c.Server, projectId, location, postgresDatabaseId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePostgresDatabase(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePostgresDatabaseRequest(c.Server, projectId, location, postgresDatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPostgresDatabaseDetails(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPostgresDatabaseDetailsRequest(c.Server, projectId, location, postgresDatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePostgresDatabaseWithBody(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePostgresDatabaseRequestWithBody(c.Server, projectId, location, postgresDatabaseName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePostgresDatabase(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body CreatePostgresDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePostgresDatabaseRequest(c.Server, projectId, location, postgresDatabaseName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePostgresFirewallRuleWithBody(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePostgresFirewallRuleRequestWithBody(c.Server, projectId, location, postgresDatabaseName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePostgresFirewallRule(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body CreatePostgresFirewallRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePostgresFirewallRuleRequest(c.Server, projectId, location, postgresDatabaseName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePostgresFirewallRule(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePostgresFirewallRuleRequest(c.Server, projectId, location, postgresDatabaseName, firewallRuleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPostgresFirewallRuleDetails(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPostgresFirewallRuleDetailsRequest(c.Server, projectId, location, postgresDatabaseName, firewallRuleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetSuperuserPasswordWithBody(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetSuperuserPasswordRequestWithBody(c.Server, projectId, location, postgresDatabaseName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetSuperuserPassword(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body ResetSuperuserPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetSuperuserPasswordRequest(c.Server, projectId, location, postgresDatabaseName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestorePostgresDatabaseWithBody(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestorePostgresDatabaseRequestWithBody(c.Server, projectId, location, postgresDatabaseName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestorePostgresDatabase(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body RestorePostgresDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestorePostgresDatabaseRequest(c.Server, projectId, location, postgresDatabaseName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListLocationPrivateSubnets(ctx context.Context, projectId ProjectId, location Location, params *ListLocationPrivateSubnetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLocationPrivateSubnetsRequest(c.Server, projectId, location, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePSWithId(ctx context.Context, projectId ProjectId, location Location, privateSubnetId PrivateSubnetId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePSWithIdRequest(c.Server, projectId, location, privateSubnetId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPSDetailsWithId(ctx context.Context, projectId ProjectId, location Location, privateSubnetId PrivateSubnetId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPSDetailsWithIdRequest(c.Server, projectId, location, privateSubnetId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePrivateSubnet(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePrivateSubnetRequest(c.Server, projectId, location, privateSubnetName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPrivateSubnetDetails(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPrivateSubnetDetailsRequest(c.Server, projectId, location, privateSubnetName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePrivateSubnetWithBody(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePrivateSubnetRequestWithBody(c.Server, projectId, location, privateSubnetName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePrivateSubnet(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, body CreatePrivateSubnetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePrivateSubnetRequest(c.Server, projectId, location, privateSubnetName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListLocationVMs(ctx context.Context, projectId ProjectId, location string, params *ListLocationVMsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLocationVMsRequest(c.Server, projectId, location, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteVMWithId(ctx context.Context, projectId ProjectId, location Location, vmId VmId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteVMWithIdRequest(c.Server, projectId, location, vmId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVMDetailsWithId(ctx context.Context, projectId ProjectId, location Location, vmId VmId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVMDetailsWithIdRequest(c.Server, projectId, location, vmId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteVM(ctx context.Context, projectId ProjectId, location Location, vmName VmName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteVMRequest(c.Server, projectId, location, vmName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVMDetails(ctx context.Context, projectId ProjectId, location Location, vmName VmName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVMDetailsRequest(c.Server, projectId, location, vmName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateVMWithBody(ctx context.Context, projectId ProjectId, location Location, vmName VmName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVMRequestWithBody(c.Server, projectId, location, vmName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateVM(ctx context.Context, projectId ProjectId, location Location, vmName VmName, body CreateVMJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVMRequest(c.Server, projectId, location, vmName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPostgresDatabases(ctx context.Context, projectId ProjectId, params *ListPostgresDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPostgresDatabasesRequest(c.Server, projectId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPSs(ctx context.Context, projectId ProjectId, params *ListPSsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPSsRequest(c.Server, projectId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSshPublicKeys(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSshPublicKeysRequest(c.Server, projectId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSshPublicKeyWithBody(ctx context.Context, projectId ProjectId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSshPublicKeyRequestWithBody(c.Server, projectId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSshPublicKey(ctx context.Context, projectId ProjectId, body CreateSshPublicKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSshPublicKeyRequest(c.Server, projectId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSshPublicKey(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSshPublicKeyRequest(c.Server, projectId, sshPublicKeyId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSshPublicKeyDetails(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSshPublicKeyDetailsRequest(c.Server, projectId, sshPublicKeyId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSshPublicKeyWithBody(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSshPublicKeyRequestWithBody(c.Server, projectId, sshPublicKeyId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSshPublicKey(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, body UpdateSshPublicKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSshPublicKeyRequest(c.Server, projectId, sshPublicKeyId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListProjectVMs(ctx context.Context, projectId ProjectId, params *ListProjectVMsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectVMsRequest(c.Server, projectId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListLocationsRequest generates requests for ListLocations
+func NewListLocationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/location")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLoginRequest calls the generic Login builder with application/json body
+func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginRequestWithBody generates requests for Login with any type of body
+func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListProjectsRequest generates requests for ListProjects
+func NewListProjectsRequest(server string, params *ListProjectsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_after", runtime.ParamLocationQuery, *params.StartAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderColumn != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order_column", runtime.ParamLocationQuery, *params.OrderColumn); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateProjectRequest calls the generic CreateProject builder with application/json body
+func NewCreateProjectRequest(server string, body CreateProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateProjectRequestWithBody generates requests for CreateProject with any type of body
+func NewCreateProjectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectRequest generates requests for DeleteProject
+func NewDeleteProjectRequest(server string, projectId ProjectId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectRequest generates requests for GetProject
+func NewGetProjectRequest(server string, projectId ProjectId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListLocationFirewallsRequest generates requests for ListLocationFirewalls
+func NewListLocationFirewallsRequest(server string, projectId ProjectId, location Location, params *ListLocationFirewallsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/firewall", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_after", runtime.ParamLocationQuery, *params.StartAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderColumn != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order_column", runtime.ParamLocationQuery, *params.OrderColumn); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteFirewallRequest generates requests for DeleteFirewall
+func NewDeleteFirewallRequest(server string, projectId ProjectId, location Location, firewallName FirewallName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "firewall_name", runtime.ParamLocationPath, firewallName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/firewall/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFirewallDetailsRequest generates requests for GetFirewallDetails
+func NewGetFirewallDetailsRequest(server string, projectId ProjectId, location Location, firewallName FirewallName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "firewall_name", runtime.ParamLocationPath, firewallName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/firewall/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateFirewallRequest calls the generic UpdateFirewall builder with application/json body
+func NewUpdateFirewallRequest(server string, projectId ProjectId, location Location, firewallName FirewallName, body UpdateFirewallJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateFirewallRequestWithBody(server, projectId, location, firewallName, "application/json", bodyReader)
+}
+
+// NewUpdateFirewallRequestWithBody generates requests for UpdateFirewall with any type of body
+func NewUpdateFirewallRequestWithBody(server string, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "firewall_name", runtime.ParamLocationPath, firewallName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/firewall/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateFirewallRequest calls the generic CreateFirewall builder with application/json body
+func NewCreateFirewallRequest(server string, projectId ProjectId, location Location, firewallName FirewallName, body CreateFirewallJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateFirewallRequestWithBody(server, projectId, location, firewallName, "application/json", bodyReader)
+}
+
+// NewCreateFirewallRequestWithBody generates requests for CreateFirewall with any type of body
+func NewCreateFirewallRequestWithBody(server string, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "firewall_name", runtime.ParamLocationPath, firewallName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/firewall/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAttachFirewallSubnetRequest calls the generic AttachFirewallSubnet builder with application/json body
+func NewAttachFirewallSubnetRequest(server string, projectId ProjectId, location Location, firewallName FirewallName, body AttachFirewallSubnetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAttachFirewallSubnetRequestWithBody(server, projectId, location, firewallName, "application/json", bodyReader)
+}
+
+// NewAttachFirewallSubnetRequestWithBody generates requests for AttachFirewallSubnet with any type of body
+func NewAttachFirewallSubnetRequestWithBody(server string, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "firewall_name", runtime.ParamLocationPath, firewallName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/firewall/%s/attach-subnet", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDetachFirewallSubnetRequest calls the generic DetachFirewallSubnet builder with application/json body
+func NewDetachFirewallSubnetRequest(server string, projectId ProjectId, location Location, firewallName FirewallName, body DetachFirewallSubnetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDetachFirewallSubnetRequestWithBody(server, projectId, location, firewallName, "application/json", bodyReader)
+}
+
+// NewDetachFirewallSubnetRequestWithBody generates requests for DetachFirewallSubnet with any type of body
+func NewDetachFirewallSubnetRequestWithBody(server string, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "firewall_name", runtime.ParamLocationPath, firewallName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/firewall/%s/detach-subnet", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateFirewallRuleRequest calls the generic CreateFirewallRule builder with application/json body
+func NewCreateFirewallRuleRequest(server string, projectId ProjectId, location Location, firewallName FirewallName, body CreateFirewallRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateFirewallRuleRequestWithBody(server, projectId, location, firewallName, "application/json", bodyReader)
+}
+
+// NewCreateFirewallRuleRequestWithBody generates requests for CreateFirewallRule with any type of body
+func NewCreateFirewallRuleRequestWithBody(server string, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "firewall_name", runtime.ParamLocationPath, firewallName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/firewall/%s/firewall-rule", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteFirewallRuleRequest generates requests for DeleteFirewallRule
+func NewDeleteFirewallRuleRequest(server string, projectId ProjectId, location Location, firewallName FirewallName, firewallRuleId FirewallRuleId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "firewall_name", runtime.ParamLocationPath, firewallName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "firewall_rule_id", runtime.ParamLocationPath, firewallRuleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/firewall/%s/firewall-rule/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFirewallRuleDetailsRequest generates requests for GetFirewallRuleDetails
+func NewGetFirewallRuleDetailsRequest(server string, projectId ProjectId, location Location, firewallName FirewallName, firewallRuleId FirewallRuleId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "firewall_name", runtime.ParamLocationPath, firewallName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "firewall_rule_id", runtime.ParamLocationPath, firewallRuleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/firewall/%s/firewall-rule/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRenameFirewallRequest calls the generic RenameFirewall builder with application/json body
+func NewRenameFirewallRequest(server string, projectId ProjectId, location Location, firewallName FirewallName, body RenameFirewallJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRenameFirewallRequestWithBody(server, projectId, location, firewallName, "application/json", bodyReader)
+}
+
+// NewRenameFirewallRequestWithBody generates requests for RenameFirewall with any type of body
+func NewRenameFirewallRequestWithBody(server string, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "firewall_name", runtime.ParamLocationPath, firewallName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/firewall/%s/rename", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListLocationPostgresDatabasesRequest generates requests for ListLocationPostgresDatabases
+func NewListLocationPostgresDatabasesRequest(server string, projectId ProjectId, location Location, params *ListLocationPostgresDatabasesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/postgres", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_after", runtime.ParamLocationQuery, *params.StartAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderColumn != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order_column", runtime.ParamLocationQuery, *params.OrderColumn); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeletePostgresDatabaseWithIDRequest generates requests for DeletePostgresDatabaseWithID
+func NewDeletePostgresDatabaseWithIDRequest(server string, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "postgres_database_id", runtime.ParamLocationPath, postgresDatabaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/postgres/id/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPostgresDetailsWithIdRequest generates requests for GetPostgresDetailsWithId
+func NewGetPostgresDetailsWithIdRequest(server string, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "postgres_database_id", runtime.ParamLocationPath, postgresDatabaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/postgres/id/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResetSuperuserPasswordWithIDRequest calls the generic ResetSuperuserPasswordWithID builder with application/json body
+func NewResetSuperuserPasswordWithIDRequest(server string, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, body ResetSuperuserPasswordWithIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewResetSuperuserPasswordWithIDRequestWithBody(server, projectId, location, postgresDatabaseId, "application/json", bodyReader)
+}
+
+// NewResetSuperuserPasswordWithIDRequestWithBody generates requests for ResetSuperuserPasswordWithID with any type of body
+func NewResetSuperuserPasswordWithIDRequestWithBody(server string, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "postgres_database_id", runtime.ParamLocationPath, postgresDatabaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/postgres/id/%s/reset-superuser-password", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRestorePostgresDatabaseWithIDRequest calls the generic RestorePostgresDatabaseWithID builder with application/json body
+func NewRestorePostgresDatabaseWithIDRequest(server string, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, body RestorePostgresDatabaseWithIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRestorePostgresDatabaseWithIDRequestWithBody(server, projectId, location, postgresDatabaseId, "application/json", bodyReader)
+}
+
+// NewRestorePostgresDatabaseWithIDRequestWithBody generates requests for RestorePostgresDatabaseWithID with any type of body
+func NewRestorePostgresDatabaseWithIDRequestWithBody(server string, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "postgres_database_id", runtime.ParamLocationPath, postgresDatabaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/postgres/id/%s/restore", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePostgresDatabaseRequest generates requests for DeletePostgresDatabase
+func NewDeletePostgresDatabaseRequest(server string, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "postgres_database_name", runtime.ParamLocationPath, postgresDatabaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/postgres/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPostgresDatabaseDetailsRequest generates requests for GetPostgresDatabaseDetails
+func NewGetPostgresDatabaseDetailsRequest(server string, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "postgres_database_name", runtime.ParamLocationPath, postgresDatabaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/postgres/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePostgresDatabaseRequest calls the generic CreatePostgresDatabase builder with application/json body
+func NewCreatePostgresDatabaseRequest(server string, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body CreatePostgresDatabaseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePostgresDatabaseRequestWithBody(server, projectId, location, postgresDatabaseName, "application/json", bodyReader)
+}
+
+// NewCreatePostgresDatabaseRequestWithBody generates requests for CreatePostgresDatabase with any type of body
+func NewCreatePostgresDatabaseRequestWithBody(server string, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "postgres_database_name", runtime.ParamLocationPath, postgresDatabaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/postgres/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreatePostgresFirewallRuleRequest calls the generic CreatePostgresFirewallRule builder with application/json body
+func NewCreatePostgresFirewallRuleRequest(server string, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body CreatePostgresFirewallRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePostgresFirewallRuleRequestWithBody(server, projectId, location, postgresDatabaseName, "application/json", bodyReader)
+}
+
+// NewCreatePostgresFirewallRuleRequestWithBody generates requests for CreatePostgresFirewallRule with any type of body
+func NewCreatePostgresFirewallRuleRequestWithBody(server string, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "postgres_database_name", runtime.ParamLocationPath, postgresDatabaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/postgres/%s/firewall-rule", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePostgresFirewallRuleRequest generates requests for DeletePostgresFirewallRule
+func NewDeletePostgresFirewallRuleRequest(server string, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, firewallRuleId FirewallRuleId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "postgres_database_name", runtime.ParamLocationPath, postgresDatabaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "firewall_rule_id", runtime.ParamLocationPath, firewallRuleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/postgres/%s/firewall-rule/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPostgresFirewallRuleDetailsRequest generates requests for GetPostgresFirewallRuleDetails
+func NewGetPostgresFirewallRuleDetailsRequest(server string, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, firewallRuleId FirewallRuleId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "postgres_database_name", runtime.ParamLocationPath, postgresDatabaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "firewall_rule_id", runtime.ParamLocationPath, firewallRuleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/postgres/%s/firewall-rule/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResetSuperuserPasswordRequest calls the generic ResetSuperuserPassword builder with application/json body
+func NewResetSuperuserPasswordRequest(server string, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body ResetSuperuserPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewResetSuperuserPasswordRequestWithBody(server, projectId, location, postgresDatabaseName, "application/json", bodyReader)
+}
+
+// NewResetSuperuserPasswordRequestWithBody generates requests for ResetSuperuserPassword with any type of body
+func NewResetSuperuserPasswordRequestWithBody(server string, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "postgres_database_name", runtime.ParamLocationPath, postgresDatabaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/postgres/%s/reset-superuser-password", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRestorePostgresDatabaseRequest calls the generic RestorePostgresDatabase builder with application/json body
+func NewRestorePostgresDatabaseRequest(server string, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body RestorePostgresDatabaseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRestorePostgresDatabaseRequestWithBody(server, projectId, location, postgresDatabaseName, "application/json", bodyReader)
+}
+
+// NewRestorePostgresDatabaseRequestWithBody generates requests for RestorePostgresDatabase with any type of body
+func NewRestorePostgresDatabaseRequestWithBody(server string, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "postgres_database_name", runtime.ParamLocationPath, postgresDatabaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/postgres/%s/restore", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListLocationPrivateSubnetsRequest generates requests for ListLocationPrivateSubnets
+func NewListLocationPrivateSubnetsRequest(server string, projectId ProjectId, location Location, params *ListLocationPrivateSubnetsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/private-subnet", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_after", runtime.ParamLocationQuery, *params.StartAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderColumn != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order_column", runtime.ParamLocationQuery, *params.OrderColumn); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeletePSWithIdRequest generates requests for DeletePSWithId
+func NewDeletePSWithIdRequest(server string, projectId ProjectId, location Location, privateSubnetId PrivateSubnetId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "private_subnet_id", runtime.ParamLocationPath, privateSubnetId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/private-subnet/id/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPSDetailsWithIdRequest generates requests for GetPSDetailsWithId
+func NewGetPSDetailsWithIdRequest(server string, projectId ProjectId, location Location, privateSubnetId PrivateSubnetId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "private_subnet_id", runtime.ParamLocationPath, privateSubnetId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/private-subnet/id/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeletePrivateSubnetRequest generates requests for DeletePrivateSubnet
+func NewDeletePrivateSubnetRequest(server string, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "private_subnet_name", runtime.ParamLocationPath, privateSubnetName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/private-subnet/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPrivateSubnetDetailsRequest generates requests for GetPrivateSubnetDetails
+func NewGetPrivateSubnetDetailsRequest(server string, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "private_subnet_name", runtime.ParamLocationPath, privateSubnetName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/private-subnet/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePrivateSubnetRequest calls the generic CreatePrivateSubnet builder with application/json body
+func NewCreatePrivateSubnetRequest(server string, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, body CreatePrivateSubnetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePrivateSubnetRequestWithBody(server, projectId, location, privateSubnetName, "application/json", bodyReader)
+}
+
+// NewCreatePrivateSubnetRequestWithBody generates requests for CreatePrivateSubnet with any type of body
+func NewCreatePrivateSubnetRequestWithBody(server string, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "private_subnet_name", runtime.ParamLocationPath, privateSubnetName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/private-subnet/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListLocationVMsRequest generates requests for ListLocationVMs
+func NewListLocationVMsRequest(server string, projectId ProjectId, location string, params *ListLocationVMsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/vm", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_after", runtime.ParamLocationQuery, *params.StartAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderColumn != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order_column", runtime.ParamLocationQuery, *params.OrderColumn); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteVMWithIdRequest generates requests for DeleteVMWithId
+func NewDeleteVMWithIdRequest(server string, projectId ProjectId, location Location, vmId VmId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "vm_id", runtime.ParamLocationPath, vmId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/vm/id/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetVMDetailsWithIdRequest generates requests for GetVMDetailsWithId
+func NewGetVMDetailsWithIdRequest(server string, projectId ProjectId, location Location, vmId VmId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "vm_id", runtime.ParamLocationPath, vmId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/vm/id/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteVMRequest generates requests for DeleteVM
+func NewDeleteVMRequest(server string, projectId ProjectId, location Location, vmName VmName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "vm_name", runtime.ParamLocationPath, vmName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/vm/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetVMDetailsRequest generates requests for GetVMDetails
+func NewGetVMDetailsRequest(server string, projectId ProjectId, location Location, vmName VmName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "vm_name", runtime.ParamLocationPath, vmName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/vm/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateVMRequest calls the generic CreateVM builder with application/json body
+func NewCreateVMRequest(server string, projectId ProjectId, location Location, vmName VmName, body CreateVMJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateVMRequestWithBody(server, projectId, location, vmName, "application/json", bodyReader)
+}
+
+// NewCreateVMRequestWithBody generates requests for CreateVM with any type of body
+func NewCreateVMRequestWithBody(server string, projectId ProjectId, location Location, vmName VmName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "location", runtime.ParamLocationPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "vm_name", runtime.ParamLocationPath, vmName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/location/%s/vm/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListPostgresDatabasesRequest generates requests for ListPostgresDatabases
+func NewListPostgresDatabasesRequest(server string, projectId ProjectId, params *ListPostgresDatabasesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/postgres", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_after", runtime.ParamLocationQuery, *params.StartAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderColumn != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order_column", runtime.ParamLocationQuery, *params.OrderColumn); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPSsRequest generates requests for ListPSs
+func NewListPSsRequest(server string, projectId ProjectId, params *ListPSsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/private-subnet", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_after", runtime.ParamLocationQuery, *params.StartAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderColumn != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order_column", runtime.ParamLocationQuery, *params.OrderColumn); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListSshPublicKeysRequest generates requests for ListSshPublicKeys
+func NewListSshPublicKeysRequest(server string, projectId ProjectId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/ssh-public-key", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSshPublicKeyRequest calls the generic CreateSshPublicKey builder with application/json body
+func NewCreateSshPublicKeyRequest(server string, projectId ProjectId, body CreateSshPublicKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSshPublicKeyRequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewCreateSshPublicKeyRequestWithBody generates requests for CreateSshPublicKey with any type of body
+func NewCreateSshPublicKeyRequestWithBody(server string, projectId ProjectId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/ssh-public-key", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSshPublicKeyRequest generates requests for DeleteSshPublicKey
+func NewDeleteSshPublicKeyRequest(server string, projectId ProjectId, sshPublicKeyId SshPublicKeyId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ssh_public_key_id", runtime.ParamLocationPath, sshPublicKeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/ssh-public-key/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSshPublicKeyDetailsRequest generates requests for GetSshPublicKeyDetails
+func NewGetSshPublicKeyDetailsRequest(server string, projectId ProjectId, sshPublicKeyId SshPublicKeyId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ssh_public_key_id", runtime.ParamLocationPath, sshPublicKeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/ssh-public-key/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSshPublicKeyRequest calls the generic UpdateSshPublicKey builder with application/json body
+func NewUpdateSshPublicKeyRequest(server string, projectId ProjectId, sshPublicKeyId SshPublicKeyId, body UpdateSshPublicKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSshPublicKeyRequestWithBody(server, projectId, sshPublicKeyId, "application/json", bodyReader)
+}
+
+// NewUpdateSshPublicKeyRequestWithBody generates requests for UpdateSshPublicKey with any type of body
+func NewUpdateSshPublicKeyRequestWithBody(server string, projectId ProjectId, sshPublicKeyId SshPublicKeyId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ssh_public_key_id", runtime.ParamLocationPath, sshPublicKeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/ssh-public-key/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListProjectVMsRequest generates requests for ListProjectVMs
+func NewListProjectVMsRequest(server string, projectId ProjectId, params *ListProjectVMsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/project/%s/vm", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_after", runtime.ParamLocationQuery, *params.StartAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderColumn != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order_column", runtime.ParamLocationQuery, *params.OrderColumn); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListLocationsWithResponse request
+	ListLocationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListLocationsResponse, error)
+
+	// LoginWithBodyWithResponse request with any body
+	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	// ListProjectsWithResponse request
+	ListProjectsWithResponse(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*ListProjectsResponse, error)
+
+	// CreateProjectWithBodyWithResponse request with any body
+	CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+
+	CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+
+	// DeleteProjectWithResponse request
+	DeleteProjectWithResponse(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error)
+
+	// GetProjectWithResponse request
+	GetProjectWithResponse(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*GetProjectResponse, error)
+
+	// ListLocationFirewallsWithResponse request
+	ListLocationFirewallsWithResponse(ctx context.Context, projectId ProjectId, location Location, params *ListLocationFirewallsParams, reqEditors ...RequestEditorFn) (*ListLocationFirewallsResponse, error)
+
+	// DeleteFirewallWithResponse request
+	DeleteFirewallWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, reqEditors ...RequestEditorFn) (*DeleteFirewallResponse, error)
+
+	// GetFirewallDetailsWithResponse request
+	GetFirewallDetailsWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, reqEditors ...RequestEditorFn) (*GetFirewallDetailsResponse, error)
+
+	// UpdateFirewallWithBodyWithResponse request with any body
+	UpdateFirewallWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFirewallResponse, error)
+
+	UpdateFirewallWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body UpdateFirewallJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFirewallResponse, error)
+
+	// CreateFirewallWithBodyWithResponse request with any body
+	CreateFirewallWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFirewallResponse, error)
+
+	CreateFirewallWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body CreateFirewallJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFirewallResponse, error)
+
+	// AttachFirewallSubnetWithBodyWithResponse request with any body
+	AttachFirewallSubnetWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachFirewallSubnetResponse, error)
+
+	AttachFirewallSubnetWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body AttachFirewallSubnetJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachFirewallSubnetResponse, error)
+
+	// DetachFirewallSubnetWithBodyWithResponse request with any body
+	DetachFirewallSubnetWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DetachFirewallSubnetResponse, error)
+
+	DetachFirewallSubnetWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body DetachFirewallSubnetJSONRequestBody, reqEditors ...RequestEditorFn) (*DetachFirewallSubnetResponse, error)
+
+	// CreateFirewallRuleWithBodyWithResponse request with any body
+	CreateFirewallRuleWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFirewallRuleResponse, error)
+
+	CreateFirewallRuleWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body CreateFirewallRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFirewallRuleResponse, error)
+
+	// DeleteFirewallRuleWithResponse request
+	DeleteFirewallRuleWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*DeleteFirewallRuleResponse, error)
+
+	// GetFirewallRuleDetailsWithResponse request
+	GetFirewallRuleDetailsWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*GetFirewallRuleDetailsResponse, error)
+
+	// RenameFirewallWithBodyWithResponse request with any body
+	RenameFirewallWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenameFirewallResponse, error)
+
+	RenameFirewallWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body RenameFirewallJSONRequestBody, reqEditors ...RequestEditorFn) (*RenameFirewallResponse, error)
+
+	// ListLocationPostgresDatabasesWithResponse request
+	ListLocationPostgresDatabasesWithResponse(ctx context.Context, projectId ProjectId, location Location, params *ListLocationPostgresDatabasesParams, reqEditors ...RequestEditorFn) (*ListLocationPostgresDatabasesResponse, error)
+
+	// DeletePostgresDatabaseWithIDWithResponse request
+	DeletePostgresDatabaseWithIDWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, reqEditors ...RequestEditorFn) (*DeletePostgresDatabaseWithIDResponse, error)
+
+	// GetPostgresDetailsWithIdWithResponse request
+	GetPostgresDetailsWithIdWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, reqEditors ...RequestEditorFn) (*GetPostgresDetailsWithIdResponse, error)
+
+	// ResetSuperuserPasswordWithIDWithBodyWithResponse request with any body
+	ResetSuperuserPasswordWithIDWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetSuperuserPasswordWithIDResponse, error)
+
+	ResetSuperuserPasswordWithIDWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, body ResetSuperuserPasswordWithIDJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetSuperuserPasswordWithIDResponse, error)
+
+	// RestorePostgresDatabaseWithIDWithBodyWithResponse request with any body
+	RestorePostgresDatabaseWithIDWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestorePostgresDatabaseWithIDResponse, error)
+
+	RestorePostgresDatabaseWithIDWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, body RestorePostgresDatabaseWithIDJSONRequestBody, reqEditors ...RequestEditorFn) (*RestorePostgresDatabaseWithIDResponse, error)
+
+	// DeletePostgresDatabaseWithResponse request
+	DeletePostgresDatabaseWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, reqEditors ...RequestEditorFn) (*DeletePostgresDatabaseResponse, error)
+
+	// GetPostgresDatabaseDetailsWithResponse request
+	GetPostgresDatabaseDetailsWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, reqEditors ...RequestEditorFn) (*GetPostgresDatabaseDetailsResponse, error)
+
+	// CreatePostgresDatabaseWithBodyWithResponse request with any body
+	CreatePostgresDatabaseWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePostgresDatabaseResponse, error)
+
+	CreatePostgresDatabaseWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body CreatePostgresDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePostgresDatabaseResponse, error)
+
+	// CreatePostgresFirewallRuleWithBodyWithResponse request with any body
+	CreatePostgresFirewallRuleWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePostgresFirewallRuleResponse, error)
+
+	CreatePostgresFirewallRuleWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body CreatePostgresFirewallRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePostgresFirewallRuleResponse, error)
+
+	// DeletePostgresFirewallRuleWithResponse request
+	DeletePostgresFirewallRuleWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*DeletePostgresFirewallRuleResponse, error)
+
+	// GetPostgresFirewallRuleDetailsWithResponse request
+	GetPostgresFirewallRuleDetailsWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*GetPostgresFirewallRuleDetailsResponse, error)
+
+	// ResetSuperuserPasswordWithBodyWithResponse request with any body
+	ResetSuperuserPasswordWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetSuperuserPasswordResponse, error)
+
+	ResetSuperuserPasswordWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body ResetSuperuserPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetSuperuserPasswordResponse, error)
+
+	// RestorePostgresDatabaseWithBodyWithResponse request with any body
+	RestorePostgresDatabaseWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestorePostgresDatabaseResponse, error)
+
+	RestorePostgresDatabaseWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body RestorePostgresDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*RestorePostgresDatabaseResponse, error)
+
+	// ListLocationPrivateSubnetsWithResponse request
+	ListLocationPrivateSubnetsWithResponse(ctx context.Context, projectId ProjectId, location Location, params *ListLocationPrivateSubnetsParams, reqEditors ...RequestEditorFn) (*ListLocationPrivateSubnetsResponse, error)
+
+	// DeletePSWithIdWithResponse request
+	DeletePSWithIdWithResponse(ctx context.Context, projectId ProjectId, location Location, privateSubnetId PrivateSubnetId, reqEditors ...RequestEditorFn) (*DeletePSWithIdResponse, error)
+
+	// GetPSDetailsWithIdWithResponse request
+	GetPSDetailsWithIdWithResponse(ctx context.Context, projectId ProjectId, location Location, privateSubnetId PrivateSubnetId, reqEditors ...RequestEditorFn) (*GetPSDetailsWithIdResponse, error)
+
+	// DeletePrivateSubnetWithResponse request
+	DeletePrivateSubnetWithResponse(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, reqEditors ...RequestEditorFn) (*DeletePrivateSubnetResponse, error)
+
+	// GetPrivateSubnetDetailsWithResponse request
+	GetPrivateSubnetDetailsWithResponse(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, reqEditors ...RequestEditorFn) (*GetPrivateSubnetDetailsResponse, error)
+
+	// CreatePrivateSubnetWithBodyWithResponse request with any body
+	CreatePrivateSubnetWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePrivateSubnetResponse, error)
+
+	CreatePrivateSubnetWithResponse(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, body CreatePrivateSubnetJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePrivateSubnetResponse, error)
+
+	// ListLocationVMsWithResponse request
+	ListLocationVMsWithResponse(ctx context.Context, projectId ProjectId, location string, params *ListLocationVMsParams, reqEditors ...RequestEditorFn) (*ListLocationVMsResponse, error)
+
+	// DeleteVMWithIdWithResponse request
+	DeleteVMWithIdWithResponse(ctx context.Context, projectId ProjectId, location Location, vmId VmId, reqEditors ...RequestEditorFn) (*DeleteVMWithIdResponse, error)
+
+	// GetVMDetailsWithIdWithResponse request
+	GetVMDetailsWithIdWithResponse(ctx context.Context, projectId ProjectId, location Location, vmId VmId, reqEditors ...RequestEditorFn) (*GetVMDetailsWithIdResponse, error)
+
+	// DeleteVMWithResponse request
+	DeleteVMWithResponse(ctx context.Context, projectId ProjectId, location Location, vmName VmName, reqEditors ...RequestEditorFn) (*DeleteVMResponse, error)
+
+	// GetVMDetailsWithResponse request
+	GetVMDetailsWithResponse(ctx context.Context, projectId ProjectId, location Location, vmName VmName, reqEditors ...RequestEditorFn) (*GetVMDetailsResponse, error)
+
+	// CreateVMWithBodyWithResponse request with any body
+	CreateVMWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, vmName VmName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVMResponse, error)
+
+	CreateVMWithResponse(ctx context.Context, projectId ProjectId, location Location, vmName VmName, body CreateVMJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVMResponse, error)
+
+	// ListPostgresDatabasesWithResponse request
+	ListPostgresDatabasesWithResponse(ctx context.Context, projectId ProjectId, params *ListPostgresDatabasesParams, reqEditors ...RequestEditorFn) (*ListPostgresDatabasesResponse, error)
+
+	// ListPSsWithResponse request
+	ListPSsWithResponse(ctx context.Context, projectId ProjectId, params *ListPSsParams, reqEditors ...RequestEditorFn) (*ListPSsResponse, error)
+
+	// ListSshPublicKeysWithResponse request
+	ListSshPublicKeysWithResponse(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*ListSshPublicKeysResponse, error)
+
+	// CreateSshPublicKeyWithBodyWithResponse request with any body
+	CreateSshPublicKeyWithBodyWithResponse(ctx context.Context, projectId ProjectId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSshPublicKeyResponse, error)
+
+	CreateSshPublicKeyWithResponse(ctx context.Context, projectId ProjectId, body CreateSshPublicKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSshPublicKeyResponse, error)
+
+	// DeleteSshPublicKeyWithResponse request
+	DeleteSshPublicKeyWithResponse(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, reqEditors ...RequestEditorFn) (*DeleteSshPublicKeyResponse, error)
+
+	// GetSshPublicKeyDetailsWithResponse request
+	GetSshPublicKeyDetailsWithResponse(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, reqEditors ...RequestEditorFn) (*GetSshPublicKeyDetailsResponse, error)
+
+	// UpdateSshPublicKeyWithBodyWithResponse request with any body
+	UpdateSshPublicKeyWithBodyWithResponse(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSshPublicKeyResponse, error)
+
+	UpdateSshPublicKeyWithResponse(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, body UpdateSshPublicKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSshPublicKeyResponse, error)
+
+	// ListProjectVMsWithResponse request
+	ListProjectVMsWithResponse(ctx context.Context, projectId ProjectId, params *ListProjectVMsParams, reqEditors ...RequestEditorFn) (*ListProjectVMsResponse, error)
+}
+
+type ListLocationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Count *int               `json:"count,omitempty"`
+		Items *[]LocationCatalog `json:"items,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLocationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLocationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Count *int       `json:"count,omitempty"`
+		Items *[]Project `json:"items,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLocationFirewallsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Count *int        `json:"count,omitempty"`
+		Items *[]Firewall `json:"items,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLocationFirewallsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLocationFirewallsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteFirewallResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteFirewallResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteFirewallResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFirewallDetailsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Firewall
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFirewallDetailsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFirewallDetailsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateFirewallResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Firewall
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateFirewallResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateFirewallResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateFirewallResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Firewall
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateFirewallResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateFirewallResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AttachFirewallSubnetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Firewall
+}
+
+// Status returns HTTPResponse.Status
+func (r AttachFirewallSubnetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AttachFirewallSubnetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DetachFirewallSubnetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Firewall
+}
+
+// Status returns HTTPResponse.Status
+func (r DetachFirewallSubnetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DetachFirewallSubnetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateFirewallRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FirewallRule
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateFirewallRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateFirewallRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteFirewallRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteFirewallRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteFirewallRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFirewallRuleDetailsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FirewallRule
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFirewallRuleDetailsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFirewallRuleDetailsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RenameFirewallResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Firewall
+}
+
+// Status returns HTTPResponse.Status
+func (r RenameFirewallResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RenameFirewallResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLocationPostgresDatabasesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Count *int        `json:"count,omitempty"`
+		Items *[]Postgres `json:"items,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLocationPostgresDatabasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLocationPostgresDatabasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePostgresDatabaseWithIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePostgresDatabaseWithIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePostgresDatabaseWithIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPostgresDetailsWithIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PostgresDetailed
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPostgresDetailsWithIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPostgresDetailsWithIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetSuperuserPasswordWithIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PostgresDetailed
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetSuperuserPasswordWithIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetSuperuserPasswordWithIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestorePostgresDatabaseWithIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Postgres
+}
+
+// Status returns HTTPResponse.Status
+func (r RestorePostgresDatabaseWithIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestorePostgresDatabaseWithIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePostgresDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePostgresDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePostgresDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPostgresDatabaseDetailsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PostgresDetailed
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPostgresDatabaseDetailsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPostgresDatabaseDetailsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePostgresDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PostgresDetailed
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePostgresDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePostgresDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePostgresFirewallRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PostgresFirewallRule
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePostgresFirewallRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePostgresFirewallRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePostgresFirewallRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePostgresFirewallRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePostgresFirewallRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPostgresFirewallRuleDetailsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PostgresFirewallRule
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPostgresFirewallRuleDetailsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPostgresFirewallRuleDetailsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetSuperuserPasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PostgresDetailed
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetSuperuserPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetSuperuserPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestorePostgresDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PostgresDetailed
+}
+
+// Status returns HTTPResponse.Status
+func (r RestorePostgresDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestorePostgresDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLocationPrivateSubnetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Count *int             `json:"count,omitempty"`
+		Items *[]PrivateSubnet `json:"items,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLocationPrivateSubnetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLocationPrivateSubnetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePSWithIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePSWithIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePSWithIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPSDetailsWithIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PrivateSubnet
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPSDetailsWithIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPSDetailsWithIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePrivateSubnetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePrivateSubnetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePrivateSubnetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPrivateSubnetDetailsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PrivateSubnet
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPrivateSubnetDetailsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPrivateSubnetDetailsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePrivateSubnetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PrivateSubnet
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePrivateSubnetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePrivateSubnetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLocationVMsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Count *int  `json:"count,omitempty"`
+		Items *[]Vm `json:"items,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLocationVMsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLocationVMsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteVMWithIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteVMWithIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteVMWithIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVMDetailsWithIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VmDetailed
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVMDetailsWithIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVMDetailsWithIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteVMResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteVMResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteVMResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVMDetailsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VmDetailed
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVMDetailsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVMDetailsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateVMResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VmDetailed
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateVMResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateVMResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPostgresDatabasesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Count *int        `json:"count,omitempty"`
+		Items *[]Postgres `json:"items,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPostgresDatabasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPostgresDatabasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPSsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Count *int             `json:"count,omitempty"`
+		Items *[]PrivateSubnet `json:"items,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPSsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPSsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSshPublicKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Count *int            `json:"count,omitempty"`
+		Items *[]SshPublicKey `json:"items,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSshPublicKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSshPublicKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSshPublicKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SshPublicKey
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSshPublicKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSshPublicKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSshPublicKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSshPublicKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSshPublicKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSshPublicKeyDetailsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SshPublicKey
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSshPublicKeyDetailsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSshPublicKeyDetailsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSshPublicKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SshPublicKey
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSshPublicKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSshPublicKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListProjectVMsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Count *int  `json:"count,omitempty"`
+		Items *[]Vm `json:"items,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectVMsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectVMsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListLocationsWithResponse request returning *ListLocationsResponse
+func (c *ClientWithResponses) ListLocationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListLocationsResponse, error) {
+	rsp, err := c.ListLocations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLocationsResponse(rsp)
+}
+
+// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
+func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.Login(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+// ListProjectsWithResponse request returning *ListProjectsResponse
+func (c *ClientWithResponses) ListProjectsWithResponse(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*ListProjectsResponse, error) {
+	rsp, err := c.ListProjects(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectsResponse(rsp)
+}
+
+// CreateProjectWithBodyWithResponse request with arbitrary body returning *CreateProjectResponse
+func (c *ClientWithResponses) CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProjectWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProject(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResponse(rsp)
+}
+
+// DeleteProjectWithResponse request returning *DeleteProjectResponse
+func (c *ClientWithResponses) DeleteProjectWithResponse(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error) {
+	rsp, err := c.DeleteProject(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectResponse(rsp)
+}
+
+// GetProjectWithResponse request returning *GetProjectResponse
+func (c *ClientWithResponses) GetProjectWithResponse(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*GetProjectResponse, error) {
+	rsp, err := c.GetProject(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectResponse(rsp)
+}
+
+// ListLocationFirewallsWithResponse request returning *ListLocationFirewallsResponse
+func (c *ClientWithResponses) ListLocationFirewallsWithResponse(ctx context.Context, projectId ProjectId, location Location, params *ListLocationFirewallsParams, reqEditors ...RequestEditorFn) (*ListLocationFirewallsResponse, error) {
+	rsp, err := c.ListLocationFirewalls(ctx, projectId, location, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLocationFirewallsResponse(rsp)
+}
+
+// DeleteFirewallWithResponse request returning *DeleteFirewallResponse
+func (c *ClientWithResponses) DeleteFirewallWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, reqEditors ...RequestEditorFn) (*DeleteFirewallResponse, error) {
+	rsp, err := c.DeleteFirewall(ctx, projectId, location, firewallName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFirewallResponse(rsp)
+}
+
+// GetFirewallDetailsWithResponse request returning *GetFirewallDetailsResponse
+func (c *ClientWithResponses) GetFirewallDetailsWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, reqEditors ...RequestEditorFn) (*GetFirewallDetailsResponse, error) {
+	rsp, err := c.GetFirewallDetails(ctx, projectId, location, firewallName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFirewallDetailsResponse(rsp)
+}
+
+// UpdateFirewallWithBodyWithResponse request with arbitrary body returning *UpdateFirewallResponse
+func (c *ClientWithResponses) UpdateFirewallWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFirewallResponse, error) {
+	rsp, err := c.UpdateFirewallWithBody(ctx, projectId, location, firewallName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFirewallResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateFirewallWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body UpdateFirewallJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFirewallResponse, error) {
+	rsp, err := c.UpdateFirewall(ctx, projectId, location, firewallName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFirewallResponse(rsp)
+}
+
+// CreateFirewallWithBodyWithResponse request with arbitrary body returning *CreateFirewallResponse
+func (c *ClientWithResponses) CreateFirewallWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFirewallResponse, error) {
+	rsp, err := c.CreateFirewallWithBody(ctx, projectId, location, firewallName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFirewallResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateFirewallWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body CreateFirewallJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFirewallResponse, error) {
+	rsp, err := c.CreateFirewall(ctx, projectId, location, firewallName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFirewallResponse(rsp)
+}
+
+// AttachFirewallSubnetWithBodyWithResponse request with arbitrary body returning *AttachFirewallSubnetResponse
+func (c *ClientWithResponses) AttachFirewallSubnetWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachFirewallSubnetResponse, error) {
+	rsp, err := c.AttachFirewallSubnetWithBody(ctx, projectId, location, firewallName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachFirewallSubnetResponse(rsp)
+}
+
+func (c *ClientWithResponses) AttachFirewallSubnetWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body AttachFirewallSubnetJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachFirewallSubnetResponse, error) {
+	rsp, err := c.AttachFirewallSubnet(ctx, projectId, location, firewallName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachFirewallSubnetResponse(rsp)
+}
+
+// DetachFirewallSubnetWithBodyWithResponse request with arbitrary body returning *DetachFirewallSubnetResponse
+func (c *ClientWithResponses) DetachFirewallSubnetWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DetachFirewallSubnetResponse, error) {
+	rsp, err := c.DetachFirewallSubnetWithBody(ctx, projectId, location, firewallName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDetachFirewallSubnetResponse(rsp)
+}
+
+func (c *ClientWithResponses) DetachFirewallSubnetWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body DetachFirewallSubnetJSONRequestBody, reqEditors ...RequestEditorFn) (*DetachFirewallSubnetResponse, error) {
+	rsp, err := c.DetachFirewallSubnet(ctx, projectId, location, firewallName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDetachFirewallSubnetResponse(rsp)
+}
+
+// CreateFirewallRuleWithBodyWithResponse request with arbitrary body returning *CreateFirewallRuleResponse
+func (c *ClientWithResponses) CreateFirewallRuleWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFirewallRuleResponse, error) {
+	rsp, err := c.CreateFirewallRuleWithBody(ctx, projectId, location, firewallName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFirewallRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateFirewallRuleWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body CreateFirewallRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFirewallRuleResponse, error) {
+	rsp, err := c.CreateFirewallRule(ctx, projectId, location, firewallName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFirewallRuleResponse(rsp)
+}
+
+// DeleteFirewallRuleWithResponse request returning *DeleteFirewallRuleResponse
+func (c *ClientWithResponses) DeleteFirewallRuleWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*DeleteFirewallRuleResponse, error) {
+	rsp, err := c.DeleteFirewallRule(ctx, projectId, location, firewallName, firewallRuleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFirewallRuleResponse(rsp)
+}
+
+// GetFirewallRuleDetailsWithResponse request returning *GetFirewallRuleDetailsResponse
+func (c *ClientWithResponses) GetFirewallRuleDetailsWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*GetFirewallRuleDetailsResponse, error) {
+	rsp, err := c.GetFirewallRuleDetails(ctx, projectId, location, firewallName, firewallRuleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFirewallRuleDetailsResponse(rsp)
+}
+
+// RenameFirewallWithBodyWithResponse request with arbitrary body returning *RenameFirewallResponse
+func (c *ClientWithResponses) RenameFirewallWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenameFirewallResponse, error) {
+	rsp, err := c.RenameFirewallWithBody(ctx, projectId, location, firewallName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRenameFirewallResponse(rsp)
+}
+
+func (c *ClientWithResponses) RenameFirewallWithResponse(ctx context.Context, projectId ProjectId, location Location, firewallName FirewallName, body RenameFirewallJSONRequestBody, reqEditors ...RequestEditorFn) (*RenameFirewallResponse, error) {
+	rsp, err := c.RenameFirewall(ctx, projectId, location, firewallName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRenameFirewallResponse(rsp)
+}
+
+// ListLocationPostgresDatabasesWithResponse request returning *ListLocationPostgresDatabasesResponse
+func (c *ClientWithResponses) ListLocationPostgresDatabasesWithResponse(ctx context.Context, projectId ProjectId, location Location, params *ListLocationPostgresDatabasesParams, reqEditors ...RequestEditorFn) (*ListLocationPostgresDatabasesResponse, error) {
+	rsp, err := c.ListLocationPostgresDatabases(ctx, projectId, location, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLocationPostgresDatabasesResponse(rsp)
+}
+
+// DeletePostgresDatabaseWithIDWithResponse request returning *DeletePostgresDatabaseWithIDResponse
+func (c *ClientWithResponses) DeletePostgresDatabaseWithIDWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, reqEditors ...RequestEditorFn) (*DeletePostgresDatabaseWithIDResponse, error) {
+	rsp, err := c.DeletePostgresDatabaseWithID(ctx, projectId, location, postgresDatabaseId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePostgresDatabaseWithIDResponse(rsp)
+}
+
+// GetPostgresDetailsWithIdWithResponse request returning *GetPostgresDetailsWithIdResponse
+func (c *ClientWithResponses) GetPostgresDetailsWithIdWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, reqEditors ...RequestEditorFn) (*GetPostgresDetailsWithIdResponse, error) {
+	rsp, err := c.GetPostgresDetailsWithId(ctx, projectId, location, postgresDatabaseId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPostgresDetailsWithIdResponse(rsp)
+}
+
+// ResetSuperuserPasswordWithIDWithBodyWithResponse request with arbitrary body returning *ResetSuperuserPasswordWithIDResponse
+func (c *ClientWithResponses) ResetSuperuserPasswordWithIDWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetSuperuserPasswordWithIDResponse, error) {
+	rsp, err := c.ResetSuperuserPasswordWithIDWithBody(ctx, projectId, location, postgresDatabaseId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetSuperuserPasswordWithIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) ResetSuperuserPasswordWithIDWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, body ResetSuperuserPasswordWithIDJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetSuperuserPasswordWithIDResponse, error) {
+	rsp, err := c.ResetSuperuserPasswordWithID(ctx, projectId, location, postgresDatabaseId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetSuperuserPasswordWithIDResponse(rsp)
+}
+
+// RestorePostgresDatabaseWithIDWithBodyWithResponse request with arbitrary body returning *RestorePostgresDatabaseWithIDResponse
+func (c *ClientWithResponses) RestorePostgresDatabaseWithIDWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestorePostgresDatabaseWithIDResponse, error) {
+	rsp, err := c.RestorePostgresDatabaseWithIDWithBody(ctx, projectId, location, postgresDatabaseId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestorePostgresDatabaseWithIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) RestorePostgresDatabaseWithIDWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseId PostgresDatabaseId, body RestorePostgresDatabaseWithIDJSONRequestBody, reqEditors ...RequestEditorFn) (*RestorePostgresDatabaseWithIDResponse, error) {
+	rsp, err := c.RestorePostgresDatabaseWithID(ctx, projectId, location, postgresDatabaseId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestorePostgresDatabaseWithIDResponse(rsp)
+}
+
+// DeletePostgresDatabaseWithResponse request returning *DeletePostgresDatabaseResponse
+func (c *ClientWithResponses) DeletePostgresDatabaseWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, reqEditors ...RequestEditorFn) (*DeletePostgresDatabaseResponse, error) {
+	rsp, err := c.DeletePostgresDatabase(ctx, projectId, location, postgresDatabaseName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePostgresDatabaseResponse(rsp)
+}
+
+// GetPostgresDatabaseDetailsWithResponse request returning *GetPostgresDatabaseDetailsResponse
+func (c *ClientWithResponses) GetPostgresDatabaseDetailsWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, reqEditors ...RequestEditorFn) (*GetPostgresDatabaseDetailsResponse, error) {
+	rsp, err := c.GetPostgresDatabaseDetails(ctx, projectId, location, postgresDatabaseName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPostgresDatabaseDetailsResponse(rsp)
+}
+
+// CreatePostgresDatabaseWithBodyWithResponse request with arbitrary body returning *CreatePostgresDatabaseResponse
+func (c *ClientWithResponses) CreatePostgresDatabaseWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePostgresDatabaseResponse, error) {
+	rsp, err := c.CreatePostgresDatabaseWithBody(ctx, projectId, location, postgresDatabaseName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePostgresDatabaseResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePostgresDatabaseWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body CreatePostgresDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePostgresDatabaseResponse, error) {
+	rsp, err := c.CreatePostgresDatabase(ctx, projectId, location, postgresDatabaseName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePostgresDatabaseResponse(rsp)
+}
+
+// CreatePostgresFirewallRuleWithBodyWithResponse request with arbitrary body returning *CreatePostgresFirewallRuleResponse
+func (c *ClientWithResponses) CreatePostgresFirewallRuleWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePostgresFirewallRuleResponse, error) {
+	rsp, err := c.CreatePostgresFirewallRuleWithBody(ctx, projectId, location, postgresDatabaseName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePostgresFirewallRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePostgresFirewallRuleWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body CreatePostgresFirewallRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePostgresFirewallRuleResponse, error) {
+	rsp, err := c.CreatePostgresFirewallRule(ctx, projectId, location, postgresDatabaseName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePostgresFirewallRuleResponse(rsp)
+}
+
+// DeletePostgresFirewallRuleWithResponse request returning *DeletePostgresFirewallRuleResponse
+func (c *ClientWithResponses) DeletePostgresFirewallRuleWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*DeletePostgresFirewallRuleResponse, error) {
+	rsp, err := c.DeletePostgresFirewallRule(ctx, projectId, location, postgresDatabaseName, firewallRuleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePostgresFirewallRuleResponse(rsp)
+}
+
+// GetPostgresFirewallRuleDetailsWithResponse request returning *GetPostgresFirewallRuleDetailsResponse
+func (c *ClientWithResponses) GetPostgresFirewallRuleDetailsWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, firewallRuleId FirewallRuleId, reqEditors ...RequestEditorFn) (*GetPostgresFirewallRuleDetailsResponse, error) {
+	rsp, err := c.GetPostgresFirewallRuleDetails(ctx, projectId, location, postgresDatabaseName, firewallRuleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPostgresFirewallRuleDetailsResponse(rsp)
+}
+
+// ResetSuperuserPasswordWithBodyWithResponse request with arbitrary body returning *ResetSuperuserPasswordResponse
+func (c *ClientWithResponses) ResetSuperuserPasswordWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetSuperuserPasswordResponse, error) {
+	rsp, err := c.ResetSuperuserPasswordWithBody(ctx, projectId, location, postgresDatabaseName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetSuperuserPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) ResetSuperuserPasswordWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body ResetSuperuserPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetSuperuserPasswordResponse, error) {
+	rsp, err := c.ResetSuperuserPassword(ctx, projectId, location, postgresDatabaseName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetSuperuserPasswordResponse(rsp)
+}
+
+// RestorePostgresDatabaseWithBodyWithResponse request with arbitrary body returning *RestorePostgresDatabaseResponse
+func (c *ClientWithResponses) RestorePostgresDatabaseWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestorePostgresDatabaseResponse, error) {
+	rsp, err := c.RestorePostgresDatabaseWithBody(ctx, projectId, location, postgresDatabaseName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestorePostgresDatabaseResponse(rsp)
+}
+
+func (c *ClientWithResponses) RestorePostgresDatabaseWithResponse(ctx context.Context, projectId ProjectId, location Location, postgresDatabaseName PostgresDatabaseName, body RestorePostgresDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*RestorePostgresDatabaseResponse, error) {
+	rsp, err := c.RestorePostgresDatabase(ctx, projectId, location, postgresDatabaseName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestorePostgresDatabaseResponse(rsp)
+}
+
+// ListLocationPrivateSubnetsWithResponse request returning *ListLocationPrivateSubnetsResponse
+func (c *ClientWithResponses) ListLocationPrivateSubnetsWithResponse(ctx context.Context, projectId ProjectId, location Location, params *ListLocationPrivateSubnetsParams, reqEditors ...RequestEditorFn) (*ListLocationPrivateSubnetsResponse, error) {
+	rsp, err := c.ListLocationPrivateSubnets(ctx, projectId, location, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLocationPrivateSubnetsResponse(rsp)
+}
+
+// DeletePSWithIdWithResponse request returning *DeletePSWithIdResponse
+func (c *ClientWithResponses) DeletePSWithIdWithResponse(ctx context.Context, projectId ProjectId, location Location, privateSubnetId PrivateSubnetId, reqEditors ...RequestEditorFn) (*DeletePSWithIdResponse, error) {
+	rsp, err := c.DeletePSWithId(ctx, projectId, location, privateSubnetId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePSWithIdResponse(rsp)
+}
+
+// GetPSDetailsWithIdWithResponse request returning *GetPSDetailsWithIdResponse
+func (c *ClientWithResponses) GetPSDetailsWithIdWithResponse(ctx context.Context, projectId ProjectId, location Location, privateSubnetId PrivateSubnetId, reqEditors ...RequestEditorFn) (*GetPSDetailsWithIdResponse, error) {
+	rsp, err := c.GetPSDetailsWithId(ctx, projectId, location, privateSubnetId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPSDetailsWithIdResponse(rsp)
+}
+
+// DeletePrivateSubnetWithResponse request returning *DeletePrivateSubnetResponse
+func (c *ClientWithResponses) DeletePrivateSubnetWithResponse(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, reqEditors ...RequestEditorFn) (*DeletePrivateSubnetResponse, error) {
+	rsp, err := c.DeletePrivateSubnet(ctx, projectId, location, privateSubnetName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePrivateSubnetResponse(rsp)
+}
+
+// GetPrivateSubnetDetailsWithResponse request returning *GetPrivateSubnetDetailsResponse
+func (c *ClientWithResponses) GetPrivateSubnetDetailsWithResponse(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, reqEditors ...RequestEditorFn) (*GetPrivateSubnetDetailsResponse, error) {
+	rsp, err := c.GetPrivateSubnetDetails(ctx, projectId, location, privateSubnetName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPrivateSubnetDetailsResponse(rsp)
+}
+
+// CreatePrivateSubnetWithBodyWithResponse request with arbitrary body returning *CreatePrivateSubnetResponse
+func (c *ClientWithResponses) CreatePrivateSubnetWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePrivateSubnetResponse, error) {
+	rsp, err := c.CreatePrivateSubnetWithBody(ctx, projectId, location, privateSubnetName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePrivateSubnetResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePrivateSubnetWithResponse(ctx context.Context, projectId ProjectId, location Location, privateSubnetName PrivateSubnetName, body CreatePrivateSubnetJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePrivateSubnetResponse, error) {
+	rsp, err := c.CreatePrivateSubnet(ctx, projectId, location, privateSubnetName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePrivateSubnetResponse(rsp)
+}
+
+// ListLocationVMsWithResponse request returning *ListLocationVMsResponse
+func (c *ClientWithResponses) ListLocationVMsWithResponse(ctx context.Context, projectId ProjectId, location string, params *ListLocationVMsParams, reqEditors ...RequestEditorFn) (*ListLocationVMsResponse, error) {
+	rsp, err := c.ListLocationVMs(ctx, projectId, location, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLocationVMsResponse(rsp)
+}
+
+// DeleteVMWithIdWithResponse request returning *DeleteVMWithIdResponse
+func (c *ClientWithResponses) DeleteVMWithIdWithResponse(ctx context.Context, projectId ProjectId, location Location, vmId VmId, reqEditors ...RequestEditorFn) (*DeleteVMWithIdResponse, error) {
+	rsp, err := c.DeleteVMWithId(ctx, projectId, location, vmId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteVMWithIdResponse(rsp)
+}
+
+// GetVMDetailsWithIdWithResponse request returning *GetVMDetailsWithIdResponse
+func (c *ClientWithResponses) GetVMDetailsWithIdWithResponse(ctx context.Context, projectId ProjectId, location Location, vmId VmId, reqEditors ...RequestEditorFn) (*GetVMDetailsWithIdResponse, error) {
+	rsp, err := c.GetVMDetailsWithId(ctx, projectId, location, vmId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVMDetailsWithIdResponse(rsp)
+}
+
+// DeleteVMWithResponse request returning *DeleteVMResponse
+func (c *ClientWithResponses) DeleteVMWithResponse(ctx context.Context, projectId ProjectId, location Location, vmName VmName, reqEditors ...RequestEditorFn) (*DeleteVMResponse, error) {
+	rsp, err := c.DeleteVM(ctx, projectId, location, vmName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteVMResponse(rsp)
+}
+
+// GetVMDetailsWithResponse request returning *GetVMDetailsResponse
+func (c *ClientWithResponses) GetVMDetailsWithResponse(ctx context.Context, projectId ProjectId, location Location, vmName VmName, reqEditors ...RequestEditorFn) (*GetVMDetailsResponse, error) {
+	rsp, err := c.GetVMDetails(ctx, projectId, location, vmName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVMDetailsResponse(rsp)
+}
+
+// CreateVMWithBodyWithResponse request with arbitrary body returning *CreateVMResponse
+func (c *ClientWithResponses) CreateVMWithBodyWithResponse(ctx context.Context, projectId ProjectId, location Location, vmName VmName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVMResponse, error) {
+	rsp, err := c.CreateVMWithBody(ctx, projectId, location, vmName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVMResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateVMWithResponse(ctx context.Context, projectId ProjectId, location Location, vmName VmName, body CreateVMJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVMResponse, error) {
+	rsp, err := c.CreateVM(ctx, projectId, location, vmName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVMResponse(rsp)
+}
+
+// ListPostgresDatabasesWithResponse request returning *ListPostgresDatabasesResponse
+func (c *ClientWithResponses) ListPostgresDatabasesWithResponse(ctx context.Context, projectId ProjectId, params *ListPostgresDatabasesParams, reqEditors ...RequestEditorFn) (*ListPostgresDatabasesResponse, error) {
+	rsp, err := c.ListPostgresDatabases(ctx, projectId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPostgresDatabasesResponse(rsp)
+}
+
+// ListPSsWithResponse request returning *ListPSsResponse
+func (c *ClientWithResponses) ListPSsWithResponse(ctx context.Context, projectId ProjectId, params *ListPSsParams, reqEditors ...RequestEditorFn) (*ListPSsResponse, error) {
+	rsp, err := c.ListPSs(ctx, projectId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPSsResponse(rsp)
+}
+
+// ListSshPublicKeysWithResponse request returning *ListSshPublicKeysResponse
+func (c *ClientWithResponses) ListSshPublicKeysWithResponse(ctx context.Context, projectId ProjectId, reqEditors ...RequestEditorFn) (*ListSshPublicKeysResponse, error) {
+	rsp, err := c.ListSshPublicKeys(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSshPublicKeysResponse(rsp)
+}
+
+// CreateSshPublicKeyWithBodyWithResponse request with arbitrary body returning *CreateSshPublicKeyResponse
+func (c *ClientWithResponses) CreateSshPublicKeyWithBodyWithResponse(ctx context.Context, projectId ProjectId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSshPublicKeyResponse, error) {
+	rsp, err := c.CreateSshPublicKeyWithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSshPublicKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSshPublicKeyWithResponse(ctx context.Context, projectId ProjectId, body CreateSshPublicKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSshPublicKeyResponse, error) {
+	rsp, err := c.CreateSshPublicKey(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSshPublicKeyResponse(rsp)
+}
+
+// DeleteSshPublicKeyWithResponse request returning *DeleteSshPublicKeyResponse
+func (c *ClientWithResponses) DeleteSshPublicKeyWithResponse(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, reqEditors ...RequestEditorFn) (*DeleteSshPublicKeyResponse, error) {
+	rsp, err := c.DeleteSshPublicKey(ctx, projectId, sshPublicKeyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSshPublicKeyResponse(rsp)
+}
+
+// GetSshPublicKeyDetailsWithResponse request returning *GetSshPublicKeyDetailsResponse
+func (c *ClientWithResponses) GetSshPublicKeyDetailsWithResponse(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, reqEditors ...RequestEditorFn) (*GetSshPublicKeyDetailsResponse, error) {
+	rsp, err := c.GetSshPublicKeyDetails(ctx, projectId, sshPublicKeyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSshPublicKeyDetailsResponse(rsp)
+}
+
+// UpdateSshPublicKeyWithBodyWithResponse request with arbitrary body returning *UpdateSshPublicKeyResponse
+func (c *ClientWithResponses) UpdateSshPublicKeyWithBodyWithResponse(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSshPublicKeyResponse, error) {
+	rsp, err := c.UpdateSshPublicKeyWithBody(ctx, projectId, sshPublicKeyId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSshPublicKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSshPublicKeyWithResponse(ctx context.Context, projectId ProjectId, sshPublicKeyId SshPublicKeyId, body UpdateSshPublicKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSshPublicKeyResponse, error) {
+	rsp, err := c.UpdateSshPublicKey(ctx, projectId, sshPublicKeyId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSshPublicKeyResponse(rsp)
+}
+
+// ListProjectVMsWithResponse request returning *ListProjectVMsResponse
+func (c *ClientWithResponses) ListProjectVMsWithResponse(ctx context.Context, projectId ProjectId, params *ListProjectVMsParams, reqEditors ...RequestEditorFn) (*ListProjectVMsResponse, error) {
+	rsp, err := c.ListProjectVMs(ctx, projectId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectVMsResponse(rsp)
+}
+
+// ParseListLocationsResponse parses an HTTP response from a ListLocationsWithResponse call
+func ParseListLocationsResponse(rsp *http.Response) (*ListLocationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLocationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Count *int               `json:"count,omitempty"`
+			Items *[]LocationCatalog `json:"items,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
+func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListProjectsResponse parses an HTTP response from a ListProjectsWithResponse call
+func ParseListProjectsResponse(rsp *http.Response) (*ListProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Count *int       `json:"count,omitempty"`
+			Items *[]Project `json:"items,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateProjectResponse parses an HTTP response from a CreateProjectWithResponse call
+func ParseCreateProjectResponse(rsp *http.Response) (*CreateProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteProjectResponse parses an HTTP response from a DeleteProjectWithResponse call
+func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectResponse parses an HTTP response from a GetProjectWithResponse call
+func ParseGetProjectResponse(rsp *http.Response) (*GetProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListLocationFirewallsResponse parses an HTTP response from a ListLocationFirewallsWithResponse call
+func ParseListLocationFirewallsResponse(rsp *http.Response) (*ListLocationFirewallsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLocationFirewallsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Count *int        `json:"count,omitempty"`
+			Items *[]Firewall `json:"items,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteFirewallResponse parses an HTTP response from a DeleteFirewallWithResponse call
+func ParseDeleteFirewallResponse(rsp *http.Response) (*DeleteFirewallResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteFirewallResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetFirewallDetailsResponse parses an HTTP response from a GetFirewallDetailsWithResponse call
+func ParseGetFirewallDetailsResponse(rsp *http.Response) (*GetFirewallDetailsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFirewallDetailsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Firewall
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateFirewallResponse parses an HTTP response from a UpdateFirewallWithResponse call
+func ParseUpdateFirewallResponse(rsp *http.Response) (*UpdateFirewallResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateFirewallResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Firewall
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateFirewallResponse parses an HTTP response from a CreateFirewallWithResponse call
+func ParseCreateFirewallResponse(rsp *http.Response) (*CreateFirewallResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateFirewallResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Firewall
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAttachFirewallSubnetResponse parses an HTTP response from a AttachFirewallSubnetWithResponse call
+func ParseAttachFirewallSubnetResponse(rsp *http.Response) (*AttachFirewallSubnetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AttachFirewallSubnetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Firewall
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDetachFirewallSubnetResponse parses an HTTP response from a DetachFirewallSubnetWithResponse call
+func ParseDetachFirewallSubnetResponse(rsp *http.Response) (*DetachFirewallSubnetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DetachFirewallSubnetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Firewall
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateFirewallRuleResponse parses an HTTP response from a CreateFirewallRuleWithResponse call
+func ParseCreateFirewallRuleResponse(rsp *http.Response) (*CreateFirewallRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateFirewallRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FirewallRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteFirewallRuleResponse parses an HTTP response from a DeleteFirewallRuleWithResponse call
+func ParseDeleteFirewallRuleResponse(rsp *http.Response) (*DeleteFirewallRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteFirewallRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetFirewallRuleDetailsResponse parses an HTTP response from a GetFirewallRuleDetailsWithResponse call
+func ParseGetFirewallRuleDetailsResponse(rsp *http.Response) (*GetFirewallRuleDetailsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFirewallRuleDetailsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FirewallRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRenameFirewallResponse parses an HTTP response from a RenameFirewallWithResponse call
+func ParseRenameFirewallResponse(rsp *http.Response) (*RenameFirewallResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RenameFirewallResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Firewall
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListLocationPostgresDatabasesResponse parses an HTTP response from a ListLocationPostgresDatabasesWithResponse call
+func ParseListLocationPostgresDatabasesResponse(rsp *http.Response) (*ListLocationPostgresDatabasesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLocationPostgresDatabasesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Count *int        `json:"count,omitempty"`
+			Items *[]Postgres `json:"items,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePostgresDatabaseWithIDResponse parses an HTTP response from a DeletePostgresDatabaseWithIDWithResponse call
+func ParseDeletePostgresDatabaseWithIDResponse(rsp *http.Response) (*DeletePostgresDatabaseWithIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePostgresDatabaseWithIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetPostgresDetailsWithIdResponse parses an HTTP response from a GetPostgresDetailsWithIdWithResponse call
+func ParseGetPostgresDetailsWithIdResponse(rsp *http.Response) (*GetPostgresDetailsWithIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPostgresDetailsWithIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PostgresDetailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResetSuperuserPasswordWithIDResponse parses an HTTP response from a ResetSuperuserPasswordWithIDWithResponse call
+func ParseResetSuperuserPasswordWithIDResponse(rsp *http.Response) (*ResetSuperuserPasswordWithIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetSuperuserPasswordWithIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PostgresDetailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestorePostgresDatabaseWithIDResponse parses an HTTP response from a RestorePostgresDatabaseWithIDWithResponse call
+func ParseRestorePostgresDatabaseWithIDResponse(rsp *http.Response) (*RestorePostgresDatabaseWithIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestorePostgresDatabaseWithIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Postgres
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePostgresDatabaseResponse parses an HTTP response from a DeletePostgresDatabaseWithResponse call
+func ParseDeletePostgresDatabaseResponse(rsp *http.Response) (*DeletePostgresDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePostgresDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetPostgresDatabaseDetailsResponse parses an HTTP response from a GetPostgresDatabaseDetailsWithResponse call
+func ParseGetPostgresDatabaseDetailsResponse(rsp *http.Response) (*GetPostgresDatabaseDetailsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPostgresDatabaseDetailsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PostgresDetailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePostgresDatabaseResponse parses an HTTP response from a CreatePostgresDatabaseWithResponse call
+func ParseCreatePostgresDatabaseResponse(rsp *http.Response) (*CreatePostgresDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePostgresDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PostgresDetailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePostgresFirewallRuleResponse parses an HTTP response from a CreatePostgresFirewallRuleWithResponse call
+func ParseCreatePostgresFirewallRuleResponse(rsp *http.Response) (*CreatePostgresFirewallRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePostgresFirewallRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PostgresFirewallRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePostgresFirewallRuleResponse parses an HTTP response from a DeletePostgresFirewallRuleWithResponse call
+func ParseDeletePostgresFirewallRuleResponse(rsp *http.Response) (*DeletePostgresFirewallRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePostgresFirewallRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetPostgresFirewallRuleDetailsResponse parses an HTTP response from a GetPostgresFirewallRuleDetailsWithResponse call
+func ParseGetPostgresFirewallRuleDetailsResponse(rsp *http.Response) (*GetPostgresFirewallRuleDetailsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPostgresFirewallRuleDetailsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PostgresFirewallRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResetSuperuserPasswordResponse parses an HTTP response from a ResetSuperuserPasswordWithResponse call
+func ParseResetSuperuserPasswordResponse(rsp *http.Response) (*ResetSuperuserPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetSuperuserPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PostgresDetailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestorePostgresDatabaseResponse parses an HTTP response from a RestorePostgresDatabaseWithResponse call
+func ParseRestorePostgresDatabaseResponse(rsp *http.Response) (*RestorePostgresDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestorePostgresDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PostgresDetailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListLocationPrivateSubnetsResponse parses an HTTP response from a ListLocationPrivateSubnetsWithResponse call
+func ParseListLocationPrivateSubnetsResponse(rsp *http.Response) (*ListLocationPrivateSubnetsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLocationPrivateSubnetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Count *int             `json:"count,omitempty"`
+			Items *[]PrivateSubnet `json:"items,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePSWithIdResponse parses an HTTP response from a DeletePSWithIdWithResponse call
+func ParseDeletePSWithIdResponse(rsp *http.Response) (*DeletePSWithIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePSWithIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetPSDetailsWithIdResponse parses an HTTP response from a GetPSDetailsWithIdWithResponse call
+func ParseGetPSDetailsWithIdResponse(rsp *http.Response) (*GetPSDetailsWithIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPSDetailsWithIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PrivateSubnet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePrivateSubnetResponse parses an HTTP response from a DeletePrivateSubnetWithResponse call
+func ParseDeletePrivateSubnetResponse(rsp *http.Response) (*DeletePrivateSubnetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePrivateSubnetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetPrivateSubnetDetailsResponse parses an HTTP response from a GetPrivateSubnetDetailsWithResponse call
+func ParseGetPrivateSubnetDetailsResponse(rsp *http.Response) (*GetPrivateSubnetDetailsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPrivateSubnetDetailsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PrivateSubnet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePrivateSubnetResponse parses an HTTP response from a CreatePrivateSubnetWithResponse call
+func ParseCreatePrivateSubnetResponse(rsp *http.Response) (*CreatePrivateSubnetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePrivateSubnetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PrivateSubnet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListLocationVMsResponse parses an HTTP response from a ListLocationVMsWithResponse call
+func ParseListLocationVMsResponse(rsp *http.Response) (*ListLocationVMsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLocationVMsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Count *int  `json:"count,omitempty"`
+			Items *[]Vm `json:"items,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteVMWithIdResponse parses an HTTP response from a DeleteVMWithIdWithResponse call
+func ParseDeleteVMWithIdResponse(rsp *http.Response) (*DeleteVMWithIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteVMWithIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetVMDetailsWithIdResponse parses an HTTP response from a GetVMDetailsWithIdWithResponse call
+func ParseGetVMDetailsWithIdResponse(rsp *http.Response) (*GetVMDetailsWithIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVMDetailsWithIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VmDetailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteVMResponse parses an HTTP response from a DeleteVMWithResponse call
+func ParseDeleteVMResponse(rsp *http.Response) (*DeleteVMResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteVMResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetVMDetailsResponse parses an HTTP response from a GetVMDetailsWithResponse call
+func ParseGetVMDetailsResponse(rsp *http.Response) (*GetVMDetailsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVMDetailsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VmDetailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateVMResponse parses an HTTP response from a CreateVMWithResponse call
+func ParseCreateVMResponse(rsp *http.Response) (*CreateVMResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateVMResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VmDetailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPostgresDatabasesResponse parses an HTTP response from a ListPostgresDatabasesWithResponse call
+func ParseListPostgresDatabasesResponse(rsp *http.Response) (*ListPostgresDatabasesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPostgresDatabasesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Count *int        `json:"count,omitempty"`
+			Items *[]Postgres `json:"items,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPSsResponse parses an HTTP response from a ListPSsWithResponse call
+func ParseListPSsResponse(rsp *http.Response) (*ListPSsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPSsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Count *int             `json:"count,omitempty"`
+			Items *[]PrivateSubnet `json:"items,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSshPublicKeysResponse parses an HTTP response from a ListSshPublicKeysWithResponse call
+func ParseListSshPublicKeysResponse(rsp *http.Response) (*ListSshPublicKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSshPublicKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Count *int            `json:"count,omitempty"`
+			Items *[]SshPublicKey `json:"items,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSshPublicKeyResponse parses an HTTP response from a CreateSshPublicKeyWithResponse call
+func ParseCreateSshPublicKeyResponse(rsp *http.Response) (*CreateSshPublicKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSshPublicKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SshPublicKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSshPublicKeyResponse parses an HTTP response from a DeleteSshPublicKeyWithResponse call
+func ParseDeleteSshPublicKeyResponse(rsp *http.Response) (*DeleteSshPublicKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSshPublicKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetSshPublicKeyDetailsResponse parses an HTTP response from a GetSshPublicKeyDetailsWithResponse call
+func ParseGetSshPublicKeyDetailsResponse(rsp *http.Response) (*GetSshPublicKeyDetailsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSshPublicKeyDetailsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SshPublicKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSshPublicKeyResponse parses an HTTP response from a UpdateSshPublicKeyWithResponse call
+func ParseUpdateSshPublicKeyResponse(rsp *http.Response) (*UpdateSshPublicKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSshPublicKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SshPublicKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListProjectVMsResponse parses an HTTP response from a ListProjectVMsWithResponse call
+func ParseListProjectVMsResponse(rsp *http.Response) (*ListProjectVMsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProjectVMsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Count *int  `json:"count,omitempty"`
+			Items *[]Vm `json:"items,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+xdWXMbuRH+K6jJPiRVpCg7jlPRU2xrvavN0lGJXu6DS8UCOSAJey4DGMq0iv89hWNu",
+	"zAzmIDWM9SZxgEaj0d340I3j0Vr5buB7yGPUunq0Akigixgi4r81JugBOs7Cgy7iP9iIrggOGPY968r6",
+	"AF0E/DVgWwSiotbIwvxbANnWGlmyYo7QyCLoa4gJsq0rRkI0suhqi1zIW2D7gFegjGBvYx0Oo6QuCR20",
+	"wHaRj5vrPBeAl61hJSLXjBvHX0HZbJ6Lj1sE/ljileOHNoiKTQja8M9aVmJaVSygb9ANHF4chWPPJ2w7",
+	"3r6wRhrWfGIjslj5Tuhq2LuFG+yJ5sAY/JcXBaqo4u1riMg+YS5DLc2QjdYwdJh1ZWFby0cAN2hB8XdU",
+	"w8Qt3CAgyuk5SOhom39xGTeOPYY2iMjWfco2BNGFDRlcQqpXmVtVCkSlwM21fpC09JrpTJGE3p6KTClz",
+	"MWKrhWkFBO8gQwsaLj3E9IKSRYAsUi6lAqVOnJTIJ8tLhXA01Jry439GK1bjblSpMiZiEs3apnS7CMKl",
+	"g1eLL2hfw8Js9iuQhcEXtNdzUiTYkCEGCVvANUOkxqRnvCSQJfVGnaZV3erO1XZ9jgkLoQNcuNpir9xu",
+	"d27zju7cEs3LN1quehGJJg0foo9i0n0fzaR8OiZ+gAjDiBZ4yv1rXSf/aebkXJO5WbVI3fodU8bpZGZU",
+	"yvvMkCvK/0TQ2rqy/jJJEMREdWMS9eGOT8OHuHlICNzz/81mcR3f5fPv7+qLCZVGeKaoJ+oXfyms/zCy",
+	"Mv0tjNsK2xrDeXdzfVeGWwoMN4M9heqBT9iCQG+jnXUIA+KbITmdACLhv4MMOv6mKIOl77MFduFGp2xv",
+	"fZ8B+RHAHcQOXDoIYE9wk0JJse4VOphXMBvTwIH7EnP+NXShBwiCtmjIS417qrUWWlNVO56xt3DBP9IK",
+	"ALDFm20kCexgtgeiRk/CiTnh4KqKDfG970Z3iFDse1XtRkV6anrnlvV0Pu2xjzq7+IBXRVuoNuYPN+/a",
+	"KV9JxQgM4WD3qhxT3dzuXgFo2wRRao2stU9cKDA+r1VN9XUl1dclVF/rqJZOwOl+zqdmPilSp+IAKAvU",
+	"OAat3TV3yAUk320qMyJXLzgjMpRBpqEz4z83JOSTaAW32OCljqYoIUyQ290v+K1VXNONLOUOigTu0NcQ",
+	"UYZskHcdJcqlX5XO8Pe4a6FnI+LssbdprGfXiEHsIFsDAHzPQyve2kIRKqKBuAiQRQDzzWWNIHEwomxB",
+	"EBc7WjCsU4afVTGgigFeDPwVr0FAsAvJ/m9doGLMaTvMGFWvw44DMV7I6uX9O2SNpT1Mr6A41oiNCiJR",
+	"3ahrCY2l7zsIes+upYtr6bq+0JtmB9NosURQmGAmYjLFTkQUxT+NFpnNF5gyLtTN+spp1JtcRV3ENCBN",
+	"gLP0eFYSeK0l8NqUAF5V+PgPN++MHTrHvkYg+VaF0YqKTZCNmc44XYg9bkiyRC4exw3+J2uUhM5f/uPi",
+	"VQp9rh0fpvruhe5SegMb05UfepoWr9WXfEuQggCRFfIY3KB0k7rodKSWcSEr+Pzwr5ffttuvn+39q80/",
+	"X37zNi/C5Rcbvm6nWElAMmnD3Y+Tnw3sdEa3tyJY+B+0b7p2KUQjW/ShnkYSy9Q43ExtGi3nYMi2PsHf",
+	"kb0QP0tdGAHfQ3wAgYM9Qzc2d5sKZT7NjMfOfXjx0g8NxxwHZf6gwWINB2U+ocHazNw15jpcmbcy0Ygc",
+	"PXc/3rljFWUtkOs8D5vhlLKafQGT0MPfFiHVRdz/8PA3wD81XRDP3fIlSmb6rQ4FN44CN5+gn03mlCZz",
+	"ThGi/xPzpjEKztUXv1dz0b9r4AyhVUgw28+47UqX8BZBgsibkG35f0vx3/tofH7782OUvxOrPPE1aWvL",
+	"WCAzW9hb+0VG39ze8DkYuNDj+cMNIIj6IVkhCnwv3kbB6WEmNPid4+8QAW9ub6zUqs26vHhxccmF4gfI",
+	"gwG2rqy/X1xeXFojkZcT/ZikzXAjpc5dn/jtxlYeLrJIKlJ3NPA9KsXw8vJSxXAYktAQBoGDZenJZyrp",
+	"Jtm9fOhH4UkNGIx8qJEzzWdYDJA1/yknduAoZ+7E3T2MrFeXL3S6lIAmqSKhK2MAckbgq7+YCnjAbKs0",
+	"TobUR2CZzup4qYW0HzBtdN/l4w031Lr6FDtI6543PXH8DRZyDnyqG0DxWeZcEWVvfXvfYczixhIHym3q",
+	"3y7EzsXKd7XeDlL64JM8vo9+1RlhkiD+pJpMkbnXjmY2p3zQa2p+ptlskM0lTMPVClG6Dh1nf2GNrC2C",
+	"ttro9UaNdMls9dufH8FH/wvyKlP2B3NNUs7Guvp0n9ErLgWpSsKJcd/B/Y2KusSqscGRXgTJ0rHUstXy",
+	"klqjzPa2T3p7S4pM0nsVDqPa4sl+JYPCme1Vh/th+hwluXa+5g6xkKhkmnI63GWoEaNghynmtq/i3E6s",
+	"p2Jqa6NL2Snr0/3hXuu0zDi4SGlcJId7lccs6tk7giBDt/E6ux9HFOE83WK+BM3l/Ioo1MGXGDNupEZF",
+	"JbmNIjYUrIQI7ZybEnqgcWs33g462FZh2iOoixxRAIGHHlJxlaJKpNzQ5DHZ9HWQzDhIYs2sulyL39Pq",
+	"khG9FoVLQUmKWSnVCQmQRlLihV7pgm4SoQHPZ2Dth14eFchOAVgprZHeT/+CWKk0TqKId4gRjHbIjrk/",
+	"rrCi9mrF1WzKSvTPOtyXaWYMiCeP0V+HyTq17awWJL9PhQLaM1g/S0bsmZT94Wfr8nBLs6VBHOfhUyEE",
+	"qTE4pkGI6TnXNA3QCq/xKuaBs6czmLjr5e64Uuknj5kTCga++32yP7DeeUeFK7x3swVY7GpjCaX3KxaF",
+	"Uu51o0IyMkiP6X0T/Sxqn2q+sPuyhWx+QXyWjMk1kNFQPFlGF4W7CSBbbYvj90dgw5wq9gE7+9tnfHhi",
+	"5FmlctE3EAopDhJSyQFupMMVy5MzU5QnVwzdiqRnxTiULjmqB7qXWW4CGYOr7TgJRg/YA2r1+o3oQCSX",
+	"WbSpoR/tNjgdlT4KlD6bVLsuL9K+PwdXKRVmmL5S6gKAyd4k5guwmB+YY1mTjc7cmq7RszWd0ppsNFxr",
+	"ukZ5a1oT3z2pPUX/jkm06/HM7CmLuu7kTsl+rKmHI2V9nwlLG6Rgbyg2KDeSV9gh79IwwF4s3ZxNAdGH",
+	"oxhW6qu6EaFB9CPWaeMIiJD18cMgdYKsD4jwgicMipQpaUlgRPavj+iIucQG6n4bVFAK3jY0XjAkgqIM",
+	"3blNTXeC894DAiUb09BD5oxtZRhgcNlLIygnNWGQSE4OtXkMqZFpBKkzlrVZo/ignDra85w9GtJej2gk",
+	"O2aPCge4zHI5bXehtWwulWuN+t1S8yfYnjzqLgky2QCQM4g/MduK+0wM9gPk+w0wPSKuKjYndmndXOsl",
+	"WZHlzxyWpaLH9lFz/vnTufUgq0ynEn9imHDSDFKaTp0Mh+IbddrdFEPVm8uEIIrYmIYBIiFFZJzeUlmG",
+	"Yihis6jCrSofW9HA5ddbZC0lp5o4WQ+7S09mlPG4goht7uJUsq6NVxPaAoR+jbN0q84eH32amKjj4ZVq",
+	"zguUzhY/iJ5Hi4tCJCs+hA+JmnYMlhWFakOxCe02Sd1sr/jvPt0r/VKxqJJJqxpQVU9l7SzmUX/HYQtc",
+	"dRaIqjWSUvVPEKzqA0v1u6WnBmGdH7IySilo9bsPF9vD3Sr6A3rJdRr8uzj6ZXhWLzlxV0n1lPd95KYT",
+	"wds5ICqthyvPe3TIZ7SaQ04xdww3qdjRFTxRlrHBhTCDyw9qRVdlNuvhJAzL5Z6zINAphdjQnlrlEks1",
+	"2BiwrU+UXGwv9HoUd+K0o6nul6G5NnnItgmMAvrrMg5Dd/fHz2bW23RfgTjrLID2cxDuiYJwp4/BVWl8",
+	"qxDcj6ThP0j4rfECakhhuD6MRe7vTG1jrs/rp292fE7qD+oCh9TIdM7sS2JAjfPpT4dqGTA3iEztbmYh",
+	"szn5Xecmi51ZWca74uYtyfGRw9HZtjTh/Lz4KtY0s9Nl97MKXruAye1e7zcWnRuuulR/UaCDwRJ5zW68",
+	"xshay6PmQSYTY8kM7rAtpqWlpHt4imX/Kcylt3W+kWWdpUWZhXYL6t8HjI/DB7hkrXrSc7i1Cpl78+1o",
+	"CQuNhp0IWexcI5A9n3ZG1tX3c82niWxNMv7Qs2vu8Gr/6GVBK5+hfp0lzd2u+J5rWNttuFx5mhlN9KTe",
+	"VD6p19xqBAYXr/wZQIn5tAHunk+PCBvmUw0WLAqjHDPMpydD16kLo+uxwnzaL5yeT2shtE5sQ5nxd24L",
+	"3LxzhUob4uP5tDj4p1fnrmr8wyjw+SiuETydT3vDpMnjkFVvQ1bfio08fpPwQnv5+8/im7jBXPswUa93",
+	"E1S/TyGf1hCPXHxB+3gj1Hx6AX7GbIsISGoDn4DC270y5KyAU6sL0vXyM351GHq5Jzryb6Iwn8O/Y3St",
+	"581gR7hI/aQpi2rvln+z+En3q0S+8Bjw0OgM4qnPHj6nHo52nrD1IiVarWpINsubNciR3c6eNe2cklzZ",
+	"2Z32FPLEtDqzFWtmNsPVKsZE6XYsp9WxQiClypl+6muo716keew6uLnHwVo7khydsjmMF1N4jzPf8Tbn",
+	"ajSeEdORr3MY7GNtml0mqeafeodJVpM1e62yqPpJ8VqOF4HdDBTc0ClNHgvY3yDcUVDxusBHrhMdgiCd",
+	"r8SPcW/RYMqdRVnoJC2IEwRR6vQ2uTo/17mnShc2kfFRkVlhSV17l/OzGz+cl5ce/uXRfCjk7UsyHpMg",
+	"lhYGU+rga7KY6kWLHpKYz6uh4+QBS55r4om9aHUSIRJxalOU3OAd8hpkhLu8NZfOT5dxcHOdZ6KQkq4I",
+	"MBk8D4TILlLbkDjqoUN6NZnAAF+E6tlC8Ubc4f7wvwEAiy+8i12TAAA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
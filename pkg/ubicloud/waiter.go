@@ -0,0 +1,75 @@
+package ubicloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// Wait calls get every interval until done returns true for the object it
+// returns, and returns that object. It stops with the error of get, or of
+// ctx when it is done, so the wait is bounded with a context deadline.
+func Wait[T any](ctx context.Context, interval time.Duration, get func(ctx context.Context) (T, error), done func(T) bool) (T, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		object, err := get(ctx)
+		if err != nil {
+			return object, err
+		}
+		if done(object) {
+			return object, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitForDeletion calls get every interval until it returns an error
+// matching ErrNotFound. It stops with any other error of get, or the error of
+// ctx when it is done.
+func WaitForDeletion(ctx context.Context, interval time.Duration, get func(ctx context.Context) error) error {
+	_, err := Wait(ctx, interval, func(ctx context.Context) (bool, error) {
+		err := get(ctx)
+		if errors.Is(err, ErrNotFound) {
+			return true, nil
+		}
+		return false, err
+	}, func(deleted bool) bool { return deleted })
+	return err
+}
+
+// WaitForVMState waits until the VM is in state, e.g. "running", checking it
+// every interval, and returns it.
+func (c *Client) WaitForVMState(ctx context.Context, projectId string, location string, name string, state string, interval time.Duration) (*VmDetailed, error) {
+	return Wait(ctx, interval, func(ctx context.Context) (*VmDetailed, error) {
+		resp, err := c.GetVMDetailsWithResponse(ctx, projectId, location, name)
+		if err != nil {
+			return nil, err
+		}
+		return resp.JSON200, CheckResponse(resp, resp.Body, http.StatusOK)
+	}, func(vm *VmDetailed) bool {
+		return vm != nil && vm.State != nil && *vm.State == state
+	})
+}
+
+// WaitForPostgresState waits until the PostgreSQL database is in state, e.g.
+// "running", checking it every interval, and returns it.
+func (c *Client) WaitForPostgresState(ctx context.Context, projectId string, location string, name string, state string, interval time.Duration) (*PostgresDetailed, error) {
+	return Wait(ctx, interval, func(ctx context.Context) (*PostgresDetailed, error) {
+		resp, err := c.GetPostgresDatabaseDetailsWithResponse(ctx, projectId, location, name)
+		if err != nil {
+			return nil, err
+		}
+		return resp.JSON200, CheckResponse(resp, resp.Body, http.StatusOK)
+	}, func(pg *PostgresDetailed) bool {
+		return pg != nil && pg.State != nil && *pg.State == state
+	})
+}
@@ -0,0 +1,80 @@
+package ubicloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWaitForVMState(t *testing.T) {
+	client, s, _, projectId := newTestClient(t)
+	s.SetTransitionReads(2)
+	createTestVms(t, client, projectId, 1)
+
+	vm, err := client.WaitForVMState(context.Background(), projectId, testLocation, "test-vm-0", "running", time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *vm.State != "running" {
+		t.Errorf("expected the VM to be running, got %s", *vm.State)
+	}
+}
+
+func TestWaitForDeletion(t *testing.T) {
+	client, s, _, projectId := newTestClient(t)
+	s.SetTransitionReads(2)
+	createTestVms(t, client, projectId, 1)
+	ctx := context.Background()
+
+	resp, err := client.DeleteVMWithResponse(ctx, projectId, testLocation, "test-vm-0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckResponse(resp, resp.Body, http.StatusNoContent); err != nil {
+		t.Fatal(err)
+	}
+
+	var reads int
+	err = WaitForDeletion(ctx, time.Millisecond, func(ctx context.Context) error {
+		reads++
+		resp, err := client.GetVMDetailsWithResponse(ctx, projectId, testLocation, "test-vm-0")
+		if err != nil {
+			return err
+		}
+		return CheckResponse(resp, resp.Body, http.StatusOK)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reads < 2 {
+		t.Errorf("expected the VM to be read until it is gone, got %d reads", reads)
+	}
+}
+
+func TestWaitError(t *testing.T) {
+	client, s, _, projectId := newTestClient(t)
+	createTestVms(t, client, projectId, 1)
+	s.InjectError(http.MethodGet, "/project/*/location/*/vm/*", http.StatusForbidden, 1)
+
+	_, err := client.WaitForVMState(context.Background(), projectId, testLocation, "test-vm-0", "running", time.Millisecond)
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("expected the error of the API, got %v", err)
+	}
+}
+
+func TestWaitTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := Wait(ctx, time.Millisecond, func(context.Context) (string, error) {
+		return "creating", nil
+	}, func(state string) bool {
+		return state == "running"
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the error of the context, got %v", err)
+	}
+}
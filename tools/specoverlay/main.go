@@ -0,0 +1,210 @@
+// Command specoverlay applies a YAML overlay to the provider code spec
+// generated by tfplugingen-openapi, before tfplugingen-framework generates
+// the schemas from it, for the parts of the schemas the OpenAPI spec can't
+// describe.
+//
+// The overlay lists attribute overrides by resource and data source name:
+//
+//	resources:
+//	  vm:
+//	    name:
+//	      computed_optional_required: required
+//	      plan_modifiers:
+//	        - custom:
+//	            imports:
+//	              - path: github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier
+//	            schema_definition: stringplanmodifier.RequiresReplace()
+//
+// computed_optional_required, sensitive and default replace the values of the
+// spec, plan_modifiers and validators are appended to them. Values use the
+// format of the spec, which the result is validated against.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+	"gopkg.in/yaml.v3"
+)
+
+// overlay maps resource and data source names to the overrides of their
+// attributes, by attribute name.
+type overlay struct {
+	Resources   map[string]map[string]attributeOverlay `yaml:"resources"`
+	DataSources map[string]map[string]attributeOverlay `yaml:"datasources"`
+}
+
+type attributeOverlay struct {
+	ComputedOptionalRequired string `yaml:"computed_optional_required"`
+	Sensitive                *bool  `yaml:"sensitive"`
+	PlanModifiers            []any  `yaml:"plan_modifiers"`
+	Validators               []any  `yaml:"validators"`
+	Default                  any    `yaml:"default"`
+}
+
+func main() {
+	var specPath, overlayPath, outputPath string
+	flag.StringVar(&specPath, "spec", "", "path to the provider code spec")
+	flag.StringVar(&overlayPath, "overlay", "", "path to the YAML overlay")
+	flag.StringVar(&outputPath, "output", "", "path to write the resulting provider code spec to")
+	flag.Parse()
+
+	if specPath == "" || overlayPath == "" || outputPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(context.Background(), specPath, overlayPath, outputPath); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, specPath string, overlayPath string, outputPath string) error {
+	document, err := os.ReadFile(specPath)
+	if err != nil {
+		return err
+	}
+	overlayDocument, err := os.ReadFile(overlayPath)
+	if err != nil {
+		return err
+	}
+
+	var o overlay
+	decoder := yaml.NewDecoder(bytes.NewReader(overlayDocument))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&o); err != nil {
+		return fmt.Errorf("parsing %s: %w", overlayPath, err)
+	}
+
+	output, err := apply(ctx, document, o)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(outputPath, output, 0o644)
+}
+
+// apply returns the spec document with the overlay applied, once validated.
+func apply(ctx context.Context, document []byte, o overlay) ([]byte, error) {
+	var s map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+	if err := decoder.Decode(&s); err != nil {
+		return nil, fmt.Errorf("parsing spec: %w", err)
+	}
+
+	err := errors.Join(
+		applyToEntries(s, "resources", o.Resources),
+		applyToEntries(s, "datasources", o.DataSources),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := spec.Validate(ctx, output); err != nil {
+		return nil, fmt.Errorf("validating spec: %w", err)
+	}
+	return append(output, '\n'), nil
+}
+
+// applyToEntries applies the overrides to the attributes of the resources or
+// data sources of the spec, depending on kind.
+func applyToEntries(s map[string]any, kind string, overrides map[string]map[string]attributeOverlay) error {
+	entries := map[string]map[string]any{}
+	list, _ := s[kind].([]any)
+	for _, e := range list {
+		entry, ok := e.(map[string]any)
+		if !ok {
+			continue
+		}
+		if name, ok := entry["name"].(string); ok {
+			entries[name] = entry
+		}
+	}
+
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(overrides)) {
+		entry, ok := entries[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s %q not found in spec", kind, name))
+			continue
+		}
+
+		attributes := map[string]map[string]any{}
+		schema, _ := entry["schema"].(map[string]any)
+		list, _ := schema["attributes"].([]any)
+		for _, a := range list {
+			attribute, ok := a.(map[string]any)
+			if !ok {
+				continue
+			}
+			if attributeName, ok := attribute["name"].(string); ok {
+				attributes[attributeName] = attribute
+			}
+		}
+
+		for _, attributeName := range slices.Sorted(maps.Keys(overrides[name])) {
+			attribute, ok := attributes[attributeName]
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s %q: attribute %q not found in spec", kind, name, attributeName))
+				continue
+			}
+			if err := applyToAttribute(attribute, overrides[name][attributeName]); err != nil {
+				errs = append(errs, fmt.Errorf("%s %q: attribute %q: %w", kind, name, attributeName, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// applyToAttribute applies the overrides to an attribute of the spec, which
+// holds its name and its definition under the key of its type.
+func applyToAttribute(attribute map[string]any, o attributeOverlay) error {
+	var definition map[string]any
+	for key, value := range attribute {
+		if key == "name" {
+			continue
+		}
+		if definition != nil {
+			return errors.New("attribute has more than one type")
+		}
+		var ok bool
+		if definition, ok = value.(map[string]any); !ok {
+			return fmt.Errorf("unexpected %s definition", key)
+		}
+	}
+	if definition == nil {
+		return errors.New("attribute has no type")
+	}
+
+	if o.ComputedOptionalRequired != "" {
+		definition["computed_optional_required"] = o.ComputedOptionalRequired
+	}
+	if o.Sensitive != nil {
+		definition["sensitive"] = *o.Sensitive
+	}
+	if len(o.PlanModifiers) > 0 {
+		existing, _ := definition["plan_modifiers"].([]any)
+		definition["plan_modifiers"] = append(existing, o.PlanModifiers...)
+	}
+	if len(o.Validators) > 0 {
+		existing, _ := definition["validators"].([]any)
+		definition["validators"] = append(existing, o.Validators...)
+	}
+	if o.Default != nil {
+		definition["default"] = o.Default
+	}
+	return nil
+}
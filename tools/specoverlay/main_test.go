@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testSpec = `{
+  "version": "0.1",
+  "provider": {"name": "ubicloud"},
+  "resources": [
+    {
+      "name": "vm",
+      "schema": {
+        "attributes": [
+          {"name": "name", "string": {"computed_optional_required": "computed"}},
+          {"name": "storage_size", "int64": {"computed_optional_required": "computed_optional"}}
+        ]
+      }
+    }
+  ],
+  "datasources": [
+    {
+      "name": "vm",
+      "schema": {
+        "attributes": [
+          {"name": "name", "string": {"computed_optional_required": "required"}}
+        ]
+      }
+    }
+  ]
+}`
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		overlay string
+		// want maps "<kind>.<name>.<attribute>" to the expected definition.
+		want map[string]string
+		err  string
+	}{
+		{
+			name: "overrides",
+			overlay: `
+resources:
+  vm:
+    name:
+      computed_optional_required: required
+      plan_modifiers:
+        - custom:
+            imports:
+              - path: github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier
+            schema_definition: stringplanmodifier.RequiresReplace()
+    storage_size:
+      computed_optional_required: computed_optional
+      default:
+        static: 40
+      validators:
+        - custom:
+            schema_definition: int64validator.AtLeast(40)
+datasources:
+  vm:
+    name:
+      sensitive: true
+`,
+			want: map[string]string{
+				"resources.vm.name":         `{"computed_optional_required":"required","plan_modifiers":[{"custom":{"imports":[{"path":"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"}],"schema_definition":"stringplanmodifier.RequiresReplace()"}}]}`,
+				"resources.vm.storage_size": `{"computed_optional_required":"computed_optional","default":{"static":40},"validators":[{"custom":{"schema_definition":"int64validator.AtLeast(40)"}}]}`,
+				"datasources.vm.name":       `{"computed_optional_required":"required","sensitive":true}`,
+			},
+		},
+		{
+			name:    "unknown resource",
+			overlay: "resources:\n  firewall:\n    name: {computed_optional_required: required}\n",
+			err:     `resources "firewall" not found in spec`,
+		},
+		{
+			name:    "unknown attribute",
+			overlay: "resources:\n  vm:\n    size: {computed_optional_required: required}\n",
+			err:     `resources "vm": attribute "size" not found in spec`,
+		},
+		{
+			name:    "unknown field",
+			overlay: "resources:\n  vm:\n    name: {required: true}\n",
+			err:     "field required not found",
+		},
+		{
+			name:    "invalid spec",
+			overlay: "resources:\n  vm:\n    name: {computed_optional_required: mandatory}\n",
+			err:     "validating spec",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			specPath := filepath.Join(dir, "spec.json")
+			overlayPath := filepath.Join(dir, "overlay.yml")
+			outputPath := filepath.Join(dir, "output.json")
+			if err := os.WriteFile(specPath, []byte(testSpec), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(overlayPath, []byte(test.overlay), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			err := run(context.Background(), specPath, overlayPath, outputPath)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("expected error %q, got %v", test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			output, err := os.ReadFile(outputPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var s map[string]any
+			if err := json.Unmarshal(output, &s); err != nil {
+				t.Fatal(err)
+			}
+			for path, want := range test.want {
+				parts := strings.Split(path, ".")
+				got := findDefinition(s, parts[0], parts[1], parts[2])
+				var wantDefinition map[string]any
+				if err := json.Unmarshal([]byte(want), &wantDefinition); err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, wantDefinition) {
+					t.Errorf("%s: expected %v, got %v", path, wantDefinition, got)
+				}
+			}
+		})
+	}
+}
+
+func findDefinition(s map[string]any, kind string, name string, attributeName string) map[string]any {
+	for _, e := range s[kind].([]any) {
+		entry := e.(map[string]any)
+		if entry["name"] != name {
+			continue
+		}
+		for _, a := range entry["schema"].(map[string]any)["attributes"].([]any) {
+			attribute := a.(map[string]any)
+			if attribute["name"] != attributeName {
+				continue
+			}
+			for key, value := range attribute {
+				if key != "name" {
+					return value.(map[string]any)
+				}
+			}
+		}
+	}
+	return nil
+}